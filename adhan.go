@@ -0,0 +1,89 @@
+package diyanet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// AdhanTrigger plays the adhan, e.g. through a speaker attached to a Raspberry Pi in a mosque.
+// Register one with [Scheduler.OnAdhan].
+type AdhanTrigger interface {
+	// Play plays the adhan for the prayer in the city. It may block until the adhan is over.
+	Play(prayer Prayer, city City) error
+}
+
+// CommandTrigger is an [AdhanTrigger] executing a command, for example an audio player:
+//
+//	diyanet.CommandTrigger{
+//		Command:  []string{"mpg123", "-q", "/home/pi/adhan.mp3"},
+//		Commands: map[diyanet.PrayerName][]string{diyanet.Fajr: {"mpg123", "-q", "/home/pi/adhan-fajr.mp3"}},
+//	}
+//
+// The command receives the prayer in the environment variables DIYANET_PRAYER (e.g. "Fajr"), DIYANET_TIME
+// (RFC 3339), DIYANET_CITY and DIYANET_CITY_ID, in addition to the environment of the process.
+type CommandTrigger struct {
+	// Command is the program and its arguments run for every prayer without an entry in Commands.
+	Command []string
+	// Commands optionally overrides Command per prayer, e.g. for a separate Fajr adhan.
+	Commands map[PrayerName][]string
+	// Timeout, if positive, kills the command if it runs longer.
+	Timeout time.Duration
+}
+
+// Play implements [AdhanTrigger] by running the command for the prayer and waiting for it to exit.
+func (t CommandTrigger) Play(prayer Prayer, city City) error {
+	command, ok := t.Commands[prayer.Name]
+	if !ok {
+		command = t.Command
+	}
+	if len(command) == 0 {
+		return fmt.Errorf(errorPrefix+"no adhan command for %s", prayer.Name)
+	}
+
+	ctx := context.Background()
+	if t.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.Timeout)
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Env = append(os.Environ(),
+		"DIYANET_PRAYER="+prayer.Name.String(),
+		"DIYANET_TIME="+prayer.Time.Format(time.RFC3339),
+		"DIYANET_CITY="+city.Name,
+		"DIYANET_CITY_ID="+strconv.Itoa(city.Id),
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf(errorPrefix+"adhan command for %s failed: %w: %s", prayer.Name, err, bytes.TrimSpace(output))
+	}
+	return nil
+}
+
+// OnAdhan registers the trigger to play the adhan at the time of the given prayers,
+// or of all prayers but Sunrise if none are given.
+// The trigger is played in its own goroutine, so a long adhan does not delay other events; errors are logged.
+// Late events (see [PrayerEvent.Late]) are skipped, since an adhan long after the prayer time would mislead.
+func (s *Scheduler) OnAdhan(trigger AdhanTrigger, prayers ...PrayerName) {
+	if len(prayers) == 0 {
+		prayers = []PrayerName{Fajr, Dhuhr, Asr, Maghrib, Isha}
+	}
+
+	s.OnReminder(Reminder{Prayers: slices.Clone(prayers)}, func(event PrayerEvent) {
+		if event.Late {
+			return
+		}
+		go func() {
+			if err := trigger.Play(event.Prayer, event.City); err != nil {
+				log.Printf("%s; adhan not played", err)
+			}
+		}()
+	})
+}
@@ -0,0 +1,73 @@
+package diyanet
+
+import (
+	"fmt"
+	"time"
+)
+
+// Adjustments holds per-prayer offsets in minutes, e.g. {Fajr: 2, Isha: -3},
+// as applied by many mosques as a precaution (ihtiyat) on top of the published times.
+type Adjustments map[PrayerName]int
+
+// Apply returns a copy of pt with the clock times shifted by the adjustments.
+// An adjustment that would shift a clock time across midnight returns an error, since the clock time alone
+// cannot express the change of date.
+// Since the clock times themselves are changed, all helpers built on them, such as
+// [NextPrayer] and the exporters, respect the adjustments.
+func (a Adjustments) Apply(pt PrayerTime) (PrayerTime, error) {
+	for name, minutes := range a {
+		if minutes == 0 {
+			continue
+		}
+
+		t, err := time.Parse("15:04", pt.Clock(name))
+		if err != nil {
+			return PrayerTime{}, fmt.Errorf(errorPrefix+"unable to adjust %s time on %s: %w",
+				name, pt.GregorianDate.Format(time.DateOnly), err)
+		}
+		adjusted := t.Add(time.Duration(minutes) * time.Minute)
+		if adjusted.Day() != t.Day() {
+			return PrayerTime{}, fmt.Errorf(errorPrefix+"adjusting %s time %s on %s by %d minutes crosses midnight",
+				name, pt.Clock(name), pt.GregorianDate.Format(time.DateOnly), minutes)
+		}
+		if err := pt.setClock(name, adjusted.Format("15:04")); err != nil {
+			return PrayerTime{}, err
+		}
+	}
+
+	return pt, nil
+}
+
+// ApplyAll returns a copy of times with the adjustments applied to every day.
+func (a Adjustments) ApplyAll(times []PrayerTime) ([]PrayerTime, error) {
+	adjusted := make([]PrayerTime, len(times))
+	for i, pt := range times {
+		var err error
+		if adjusted[i], err = a.Apply(pt); err != nil {
+			return nil, err
+		}
+	}
+
+	return adjusted, nil
+}
+
+// setClock replaces the raw clock time of the given prayer.
+func (pt *PrayerTime) setClock(name PrayerName, clock string) error {
+	switch name {
+	case Fajr:
+		pt.Fajr = clock
+	case Sunrise:
+		pt.Sunrise = clock
+	case Dhuhr:
+		pt.Dhuhr = clock
+	case Asr:
+		pt.Asr = clock
+	case Maghrib:
+		pt.Maghrib = clock
+	case Isha:
+		pt.Isha = clock
+	default:
+		return fmt.Errorf(errorPrefix+"invalid prayer name %d", int(name))
+	}
+	return nil
+}
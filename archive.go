@@ -0,0 +1,175 @@
+package diyanet
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// archiveFormat identifies the header line of an archive written by [ArchiveWriter].
+const archiveFormat = "diyanet-prayer-times"
+
+// archiveVersion is the version of the archive format written by [ArchiveWriter].
+const archiveVersion = 1
+
+// archiveHeader is the first line of an archive.
+type archiveHeader struct {
+	Format  string `json:"format"`
+	Version int    `json:"version"`
+}
+
+// archiveRecord is one day of prayer times in an archive. It holds the fields of [PrayerTime]
+// under short keys; the clock times are stored in the order of [PrayerNames].
+type archiveRecord struct {
+	CityId         int       `json:"c"`
+	Date           string    `json:"d"`
+	TimeZone       string    `json:"z"`
+	Offset         float32   `json:"o"`
+	Times          [6]string `json:"t"`
+	Astronomical   [2]string `json:"a"`
+	Qibla          string    `json:"q,omitempty"`
+	Hijri          time.Time `json:"h"`
+	HijriShort     string    `json:"hs"`
+	HijriLong      string    `json:"hl"`
+	GregorianShort string    `json:"gs"`
+	GregorianLong  string    `json:"gl"`
+	Moon           string    `json:"m,omitempty"`
+}
+
+// ArchiveWriter writes prayer times as a compact archive: gzip-compressed JSON lines, one line per city and day,
+// after a header line identifying the format. A year of prayer times of all Turkish districts fits in a few megabytes.
+// Read archives with [ReadArchive].
+type ArchiveWriter struct {
+	gz     *gzip.Writer
+	enc    *json.Encoder
+	header bool
+}
+
+// NewArchiveWriter returns a writer writing an archive to w. It must be closed to complete the archive.
+func NewArchiveWriter(w io.Writer) *ArchiveWriter {
+	gz, _ := gzip.NewWriterLevel(w, gzip.BestCompression)
+	return &ArchiveWriter{gz: gz, enc: json.NewEncoder(gz)}
+}
+
+// Write appends the prayer times of the timetable to the archive.
+func (a *ArchiveWriter) Write(t Timetable) error {
+	if !a.header {
+		if err := a.enc.Encode(archiveHeader{Format: archiveFormat, Version: archiveVersion}); err != nil {
+			return fmt.Errorf(errorPrefix+"unable to write archive: %w", err)
+		}
+		a.header = true
+	}
+
+	for _, pt := range t {
+		record := archiveRecord{
+			CityId:         pt.CityId,
+			Date:           dateKey(pt.GregorianDate),
+			TimeZone:       pt.GregorianDate.Location().String(),
+			Offset:         pt.GreenwichMeanTimeZone,
+			Astronomical:   [2]string{pt.AstronomicalSunrise, pt.AstronomicalSunset},
+			Qibla:          pt.QiblaTime,
+			Hijri:          pt.HijriDate,
+			HijriShort:     pt.HijriDateShort,
+			HijriLong:      pt.HijriDateLong,
+			GregorianShort: pt.GregorianDateShort,
+			GregorianLong:  pt.GregorianDateLong,
+			Moon:           pt.ShapeMoonURL,
+		}
+		for i, name := range PrayerNames {
+			record.Times[i] = pt.Clock(name)
+		}
+
+		if err := a.enc.Encode(record); err != nil {
+			return fmt.Errorf(errorPrefix+"unable to write archive: %w", err)
+		}
+	}
+	return nil
+}
+
+// Close completes the archive. It does not close the underlying writer.
+func (a *ArchiveWriter) Close() error {
+	if !a.header {
+		if err := a.Write(nil); err != nil {
+			return err
+		}
+	}
+	if err := a.gz.Close(); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write archive: %w", err)
+	}
+	return nil
+}
+
+// ReadArchive reads an archive written by [ArchiveWriter] and returns its timetables by city ID.
+func ReadArchive(r io.Reader) (map[int]Timetable, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to read archive: %w", err)
+	}
+	defer gz.Close()
+
+	dec := json.NewDecoder(bufio.NewReader(gz))
+	var header archiveHeader
+	if err := dec.Decode(&header); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to read archive header: %w", err)
+	}
+	if header.Format != archiveFormat || header.Version != archiveVersion {
+		return nil, fmt.Errorf(errorPrefix+"unsupported archive format %s version %d", header.Format, header.Version)
+	}
+
+	days := make(map[int][]PrayerTime)
+	for {
+		var record archiveRecord
+		if err := dec.Decode(&record); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"unable to read archive: %w", err)
+		}
+
+		pt, err := record.prayerTime()
+		if err != nil {
+			return nil, err
+		}
+		days[pt.CityId] = append(days[pt.CityId], pt)
+	}
+
+	timetables := make(map[int]Timetable, len(days))
+	for id, times := range days {
+		timetables[id] = NewTimetable(times)
+	}
+	return timetables, nil
+}
+
+func (r archiveRecord) prayerTime() (PrayerTime, error) {
+	loc, err := time.LoadLocation(r.TimeZone)
+	if err != nil {
+		loc = time.FixedZone(r.TimeZone, int(r.Offset*3600))
+	}
+	date, err := time.ParseInLocation(time.DateOnly, r.Date, loc)
+	if err != nil {
+		return PrayerTime{}, fmt.Errorf(errorPrefix+"invalid date %q in archive: %w", r.Date, err)
+	}
+
+	pt := PrayerTime{
+		ShapeMoonURL:          r.Moon,
+		AstronomicalSunrise:   r.Astronomical[0],
+		AstronomicalSunset:    r.Astronomical[1],
+		HijriDateShort:        r.HijriShort,
+		HijriDateLong:         r.HijriLong,
+		HijriDate:             r.Hijri,
+		QiblaTime:             r.Qibla,
+		GregorianDateShort:    r.GregorianShort,
+		GregorianDateLong:     r.GregorianLong,
+		GregorianDate:         date,
+		GreenwichMeanTimeZone: r.Offset,
+		CityId:                r.CityId,
+	}
+	for i, name := range PrayerNames {
+		if err := pt.setClock(name, r.Times[i]); err != nil {
+			return PrayerTime{}, err
+		}
+	}
+	return pt, nil
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
 	"strings"
@@ -66,14 +65,40 @@ type tokenSource struct {
 	refreshToken string
 }
 
+// tokenError is an error of [tokenSource.Token]. Data requests failing with it are not retried, since
+// login and token refresh requests are already retried by the token source.
+type tokenError struct {
+	err error
+}
+
+func (e *tokenError) Error() string {
+	return e.err.Error()
+}
+
+func (e *tokenError) Unwrap() error {
+	return e.err
+}
+
 // Token implements [oauth2.TokenSource].
 func (t *tokenSource) Token() (*oauth2.Token, error) {
-	client := oauth2.NewClient(t.ctx, nil)
-	defer client.CloseIdleConnections()
+	token, err := t.token()
+	if err != nil {
+		return nil, &tokenError{err}
+	}
+	return token, nil
+}
+
+// token requests an access token, refreshing the previous one if possible and logging in otherwise.
+func (t *tokenSource) token() (*oauth2.Token, error) {
+	client := t.conf.AuthHTTPClient
+	if client == nil {
+		client = oauth2.NewClient(t.ctx, nil)
+	}
 
 	if t.accessToken != "" &&
 		t.refreshToken != "" &&
 		getExpirationTime(t.accessToken).Round(0).Add(-10*time.Second).After(time.Now()) {
+		t.conf.incCounter(MetricAuthRefreshes)
 		token, err := t.requestAccessToken(
 			client,
 			"GET",
@@ -84,51 +109,64 @@ func (t *tokenSource) Token() (*oauth2.Token, error) {
 		if err == nil {
 			return token, nil
 		}
+		t.conf.incCounter(MetricAuthRefreshFallbacks)
 		log.Println(err)
 	}
 
-	jsonData := struct {
-		Email    string `json:"email"`
-		Password string `json:"password"`
-	}{
-		Email:    t.conf.Email,
-		Password: t.conf.Password,
+	creds, err := t.conf.credentials(t.context())
+	if err != nil {
+		t.conf.incCounter(MetricAuthFailures)
+		return nil, fmt.Errorf(retrieveTokenErrorPrefix+"%w", err)
 	}
 
-	reqBody, err := json.Marshal(jsonData)
+	reqBody, err := json.Marshal(creds)
 	if err != nil {
+		t.conf.incCounter(MetricAuthFailures)
 		return nil, fmt.Errorf(retrieveTokenErrorPrefix+"failed to marshal request body: %w", err)
 	}
 
+	t.conf.incCounter(MetricAuthLogins)
 	token, err := t.requestAccessToken(
 		client,
 		"POST",
 		apiURLLogin,
 		func(req *http.Request) { req.Header.Set("Content-Type", "application/json") },
-		bytes.NewBuffer(reqBody),
+		reqBody,
 		retrieveTokenErrorPrefix)
 
 	if err != nil {
+		t.conf.incCounter(MetricAuthFailures)
 		return nil, err
 	}
 	return token, nil
 }
 
+// context returns the context of the token source, defaulting to [context.Background].
+func (t *tokenSource) context() context.Context {
+	if t.ctx == nil {
+		return context.Background()
+	}
+	return t.ctx
+}
+
 func (t *tokenSource) requestAccessToken(
 	client *http.Client,
 	method string,
 	url string,
 	requestProcessor func(*http.Request),
-	body io.Reader,
+	body []byte,
 	errorPrefix string) (*oauth2.Token, error) {
-	req, err := http.NewRequest(method, url, body)
-	if err != nil {
-		return nil, err
-	}
-	if requestProcessor != nil {
-		requestProcessor(req)
-	}
-	resp, err := client.Do(req)
+	ctx := t.context()
+	resp, err := t.conf.Retry.do(ctx, client, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		if requestProcessor != nil {
+			requestProcessor(req)
+		}
+		return req, nil
+	})
 
 	if err != nil {
 		return nil, fmt.Errorf("%sfailed to make refresh token request: %w", errorPrefix, err)
@@ -1,5 +1,10 @@
 package diyanet
 
+import (
+	"net/http"
+	"time"
+)
+
 const apiURLPrefix = "https://awqatsalah.diyanet.gov.tr/"
 const errorPrefix = "diyanet: "
 
@@ -10,6 +15,70 @@ type Config struct {
 
 	// Password is the user's password used for authentication.
 	Password string
+
+	// Credentials optionally supplies the email address and password at login time.
+	// If set, it takes precedence over Email and Password.
+	Credentials CredentialProvider
+
+	// AuthHTTPClient is the HTTP client used for login and token refresh requests.
+	// If nil, the client carried by the context (see [golang.org/x/oauth2.HTTPClient]) is used,
+	// falling back to [http.DefaultClient].
+	AuthHTTPClient *http.Client
+
+	// Timezones optionally resolves the IANA time zone of a city when prayer times are requested
+	// without an explicit timezone, so that every day gets the offset valid on that day,
+	// including across DST transitions. If nil, a fixed zone is built from the GMT offset reported by the API.
+	Timezones *TimezoneResolver
+
+	// Geocoder optionally resolves free-text addresses and coordinates to places,
+	// enabling [Client.FindCityByAddress] and [Client.NearestCity].
+	Geocoder Geocoder
+
+	// PlaceCacheTTL is how long countries, states, cities and city details are cached by the client,
+	// since they change at most a few times a year. Zero selects [DefaultPlaceCacheTTL];
+	// a negative value disables caching.
+	PlaceCacheTTL time.Duration
+
+	// PrayerTimeCacheTTL is how long monthly and Ramadan prayer times are cached by the client,
+	// since they only shift by a day within it. Zero selects [DefaultPrayerTimeCacheTTL];
+	// a negative value disables caching them.
+	PrayerTimeCacheTTL time.Duration
+
+	// Cache stores responses of cached endpoints (see [Cache]), keyed by request URL and language.
+	// If nil, a new [MemoryCache] is used, as the client always cached places and the daily content in memory;
+	// use [NopCache] to disable caching.
+	Cache Cache
+
+	// StaleWhileRevalidate is how long cached responses are still served after they expired,
+	// while a background request refreshes them, so that lookups never wait for the API once cached.
+	// Zero serves expired responses never.
+	StaleWhileRevalidate time.Duration
+
+	// OnTimetableChange, if set, is called for every day whose prayer times differ when cached monthly
+	// prayer times are refreshed, since Diyanet occasionally corrects times mid-month. It requires the monthly
+	// prayer times to be cached, see PrayerTimeCacheTTL; refreshes happen in the background with
+	// StaleWhileRevalidate or explicitly via [Client.RefreshPrayerTimes]. It is called synchronously during the refresh.
+	OnTimetableChange func(TimetableChange)
+
+	// OfflineOnly serves all requests exclusively from Cache and fails with [ErrOffline] if a response is missing.
+	// Use [Client.Prefetch] beforehand to populate the cache.
+	OfflineOnly bool
+
+	// DailyContentLocation is the time zone whose calendar days delimit the daily content,
+	// which is cached until the end of the current day. If nil, the time zone of Türkiye is used.
+	DailyContentLocation *time.Location
+
+	// Language is the preferred language of content such as the daily content, sent as Accept-Language header.
+	// The Diyanet Awqat Salah API has no documented language parameter and may ignore it.
+	// If empty, no preference is sent. See also [Client.WithLanguage].
+	Language Language
+
+	// Retry is the retry policy applied to data, login and token refresh requests.
+	// The zero value selects [DefaultRetryPolicy].
+	Retry RetryPolicy
+
+	// Metrics optionally receives counters about authentication activity.
+	Metrics Metrics
 }
 
 // Result is a generic response envelope returned by Diyanet Awqat Salah APIs.
@@ -0,0 +1,198 @@
+// Package bboltcache provides a persistent [diyanet.Cache] stored in a bbolt database,
+// for single-binary deployments that cannot ship CGO or an SQLite driver.
+// It has the same semantics as [diyanet.SQLCache], including the storage of parsed timetables.
+package bboltcache
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	diyanet "github.com/abduelhamit/DiyanetAwqatSalahAPI"
+	bolt "go.etcd.io/bbolt"
+)
+
+const errorPrefix = "diyanet: "
+
+var (
+	responsesBucket   = []byte("diyanet_cache")
+	prayerTimesBucket = []byte("diyanet_prayer_times")
+)
+
+// Cache is a [diyanet.Cache] stored in a bbolt database.
+type Cache struct {
+	db *bolt.DB
+}
+
+// New returns a cache stored in db, creating its buckets if they do not exist yet.
+// The database is opened and closed by the caller.
+func New(db *bolt.DB) (*Cache, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{responsesBucket, prayerTimesBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to create cache buckets: %w", err)
+	}
+	return &Cache{db: db}, nil
+}
+
+// Get implements [diyanet.Cache]. Database errors are reported as misses.
+func (c *Cache) Get(_ context.Context, key string) ([]byte, bool) {
+	var value []byte
+	_ = c.db.View(func(tx *bolt.Tx) error {
+		entry := tx.Bucket(responsesBucket).Get([]byte(key))
+		if expires, ok := expiry(entry); ok && time.Now().Before(expires) {
+			value = bytes.Clone(entry[8:])
+		}
+		return nil
+	})
+	return value, value != nil
+}
+
+// Set implements [diyanet.Cache]. Database errors are ignored.
+func (c *Cache) Set(_ context.Context, key string, value []byte, ttl time.Duration) {
+	entry := binary.BigEndian.AppendUint64(nil, uint64(time.Now().Add(ttl).UnixMilli()))
+	entry = append(entry, value...)
+
+	_ = c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(responsesBucket).Put([]byte(key), entry)
+	})
+}
+
+// Prune deletes expired responses.
+func (c *Cache) Prune(context.Context) error {
+	now := time.Now()
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(responsesBucket)
+
+		var expired [][]byte
+		err := bucket.ForEach(func(key, entry []byte) error {
+			if expires, ok := expiry(entry); !ok || !now.Before(expires) {
+				expired = append(expired, bytes.Clone(key))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range expired {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf(errorPrefix+"unable to prune cache: %w", err)
+	}
+	return nil
+}
+
+// Entries implements [diyanet.CacheInspector].
+func (c *Cache) Entries(context.Context) ([]diyanet.CacheEntry, error) {
+	var entries []diyanet.CacheEntry
+	err := c.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(responsesBucket).ForEach(func(key, entry []byte) error {
+			expires, _ := expiry(entry)
+			entries = append(entries, diyanet.CacheEntry{
+				Key:     string(key),
+				Expires: expires,
+				Size:    max(len(entry)-8, 0),
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to list cache entries: %w", err)
+	}
+	return entries, nil
+}
+
+// Delete implements [diyanet.CacheInspector].
+func (c *Cache) Delete(_ context.Context, keys ...string) error {
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(responsesBucket)
+		for _, key := range keys {
+			if err := bucket.Delete([]byte(key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf(errorPrefix+"unable to delete cache entries: %w", err)
+	}
+	return nil
+}
+
+// StoreTimetable stores the prayer times of the timetable under their CityId and date,
+// replacing previously stored days.
+func (c *Cache) StoreTimetable(_ context.Context, t diyanet.Timetable) error {
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(prayerTimesBucket)
+		for _, pt := range t {
+			data, err := json.Marshal(pt)
+			if err != nil {
+				return fmt.Errorf("unable to encode prayer times of %s: %w", dateKey(pt.GregorianDate), err)
+			}
+			if err := bucket.Put(prayerTimeKey(pt.CityId, pt.GregorianDate), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf(errorPrefix+"unable to store timetable: %w", err)
+	}
+	return nil
+}
+
+// Timetable returns the stored prayer times of the city from start to end, both dates inclusive.
+func (c *Cache) Timetable(_ context.Context, cityID int, start, end time.Time) (diyanet.Timetable, error) {
+	var times []diyanet.PrayerTime
+	last := prayerTimeKey(cityID, end)
+
+	err := c.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(prayerTimesBucket).Cursor()
+		key, data := cursor.Seek(prayerTimeKey(cityID, start))
+		for ; key != nil && bytes.Compare(key, last) <= 0; key, data = cursor.Next() {
+			var pt diyanet.PrayerTime
+			if err := json.Unmarshal(data, &pt); err != nil {
+				return fmt.Errorf("unable to decode stored prayer times %s: %w", key, err)
+			}
+			times = append(times, pt)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to load timetable of city %d: %w", cityID, err)
+	}
+
+	return diyanet.NewTimetable(times), nil
+}
+
+// expiry returns the expiry time stored at the start of a response entry.
+func expiry(entry []byte) (time.Time, bool) {
+	if len(entry) < 8 {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(entry))), true
+}
+
+// prayerTimeKey returns the key of a city's prayer times on a date. Keys of a city sort by date.
+func prayerTimeKey(cityID int, date time.Time) []byte {
+	return fmt.Appendf(nil, "%d/%s", cityID, dateKey(date))
+}
+
+func dateKey(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
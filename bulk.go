@@ -0,0 +1,152 @@
+package diyanet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"time"
+)
+
+// DefaultBulkInterval is the pause between two requests of [Client.BulkDownload] when none is given.
+const DefaultBulkInterval = time.Second
+
+// TimetableStore persists timetables per city, for example [SQLCache].
+type TimetableStore interface {
+	// StoreTimetable stores the prayer times under their CityId and date, replacing stored days.
+	StoreTimetable(ctx context.Context, t Timetable) error
+	// Timetable returns the stored prayer times of the city from start to end, both dates inclusive.
+	Timetable(ctx context.Context, cityID int, start, end time.Time) (Timetable, error)
+}
+
+// BulkDownloadSpec describes a run of [Client.BulkDownload].
+type BulkDownloadSpec struct {
+	// Store receives the downloaded prayer times.
+	Store TimetableStore
+	// Filter selects the cities to download; all cities are downloaded if nil.
+	Filter func(City) bool
+	// Checkpoint is the path of a file recording the progress, so that an interrupted run can be resumed.
+	// If empty, progress is not recorded.
+	Checkpoint string
+	// Interval is the pause between two requests to stay polite to the API. Defaults to [DefaultBulkInterval].
+	Interval time.Duration
+	// Progress, if set, is called after every downloaded city.
+	Progress func(done, total int)
+}
+
+// bulkCheckpoint is the content of [BulkDownloadSpec.Checkpoint].
+type bulkCheckpoint struct {
+	// Date is the day the run started; a checkpoint of another day is discarded.
+	Date string `json:"date"`
+	// Done holds the IDs of the cities already downloaded.
+	Done []int `json:"done"`
+}
+
+// BulkDownload downloads the monthly prayer times of every city selected by the spec into the store,
+// one city at a time. Since the Diyanet Awqat Salah API only serves the next 30 days, a year of prayer times
+// is collected by running the download about once a month; the store accumulates the days.
+//
+// With a checkpoint file, a run interrupted by an error or by ctx can be resumed on the same day
+// and skips the cities already downloaded; on another day a new run starts over.
+func (c Client) BulkDownload(ctx context.Context, spec BulkDownloadSpec) error {
+	if spec.Store == nil {
+		return errors.New(errorPrefix + "no timetable store given for bulk download")
+	}
+	interval := spec.Interval
+	if interval <= 0 {
+		interval = DefaultBulkInterval
+	}
+
+	checkpoint, err := readBulkCheckpoint(spec.Checkpoint)
+	if err != nil {
+		return err
+	}
+
+	cities, err := c.GetCities()
+	if err != nil {
+		return err
+	}
+	if spec.Filter != nil {
+		cities = slices.DeleteFunc(cities, func(city City) bool { return !spec.Filter(city) })
+	}
+
+	for i, city := range cities {
+		if slices.Contains(checkpoint.Done, city.Id) {
+			continue
+		}
+		if len(checkpoint.Done) > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+
+		times, err := city.GetPrayerTimeMonthly(nil)
+		if err != nil {
+			return err
+		}
+		if err := spec.Store.StoreTimetable(ctx, NewTimetable(times)); err != nil {
+			return err
+		}
+
+		checkpoint.Done = append(checkpoint.Done, city.Id)
+		if err := writeBulkCheckpoint(spec.Checkpoint, checkpoint); err != nil {
+			return err
+		}
+		if spec.Progress != nil {
+			spec.Progress(i+1, len(cities))
+		}
+	}
+
+	return nil
+}
+
+// readBulkCheckpoint reads the checkpoint at path, returning an empty one for today
+// if path is empty, does not exist or belongs to another day.
+func readBulkCheckpoint(path string) (bulkCheckpoint, error) {
+	fresh := bulkCheckpoint{Date: dateKey(time.Now())}
+	if path == "" {
+		return fresh, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fresh, nil
+	}
+	if err != nil {
+		return bulkCheckpoint{}, fmt.Errorf(errorPrefix+"unable to read bulk download checkpoint: %w", err)
+	}
+
+	var checkpoint bulkCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return bulkCheckpoint{}, fmt.Errorf(errorPrefix+"unable to decode bulk download checkpoint: %w", err)
+	}
+	if checkpoint.Date != fresh.Date {
+		return fresh, nil
+	}
+	return checkpoint, nil
+}
+
+// writeBulkCheckpoint replaces the checkpoint at path atomically, unless path is empty.
+func writeBulkCheckpoint(path string, checkpoint bulkCheckpoint) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write bulk download checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write bulk download checkpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package diyanet
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultPlaceCacheTTL is the time places are cached for when [Config.PlaceCacheTTL] is zero.
+const DefaultPlaceCacheTTL = 30 * 24 * time.Hour
+
+// DefaultPrayerTimeCacheTTL is the time monthly and Ramadan prayer times are cached for when
+// [Config.PrayerTimeCacheTTL] is zero.
+const DefaultPrayerTimeCacheTTL = 24 * time.Hour
+
+// Cache stores response bodies of the Diyanet Awqat Salah API, keyed by endpoint and parameters.
+// The client consults it before making a request to a cached endpoint and stores successful responses in it.
+// Implementations must be safe for concurrent use; failures should be reported as misses.
+//
+// The cached endpoints are the places ([Config.PlaceCacheTTL]), the monthly and Ramadan prayer times
+// ([Config.PrayerTimeCacheTTL]) and the daily content (until midnight, see [Config.DailyContentLocation]).
+// The daily and weekly prayer times are never cached, since they start at the current day of the API
+// and a cached copy would be off by a day after midnight; use the monthly prayer times to cache them.
+// Login and token refresh requests are never cached either.
+type Cache interface {
+	// Get returns the unexpired value stored under key.
+	Get(ctx context.Context, key string) ([]byte, bool)
+	// Set stores value under key for the duration of ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+}
+
+// NopCache is a [Cache] that stores nothing.
+var NopCache Cache = nopCache{}
+
+type nopCache struct{}
+
+func (nopCache) Get(context.Context, string) ([]byte, bool) {
+	return nil, false
+}
+
+func (nopCache) Set(context.Context, string, []byte, time.Duration) {}
+
+// cache returns the configured cache or a new in-memory cache.
+func (c Config) cache() Cache {
+	if c.Cache != nil {
+		return c.Cache
+	}
+	return NewMemoryCache()
+}
+
+// MemoryCache is a concurrency-safe in-memory [Cache] with per-entry expiry. It is the default cache of the client.
+// How long responses are kept depends on the endpoint: places for [Config.PlaceCacheTTL], the daily content
+// until midnight in [Config.DailyContentLocation], and monthly and Ramadan prayer times for
+// [Config.PrayerTimeCacheTTL].
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+// NewMemoryCache returns an empty in-memory cache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]cacheEntry)}
+}
+
+// Get returns the unexpired entry stored under key.
+func (m *MemoryCache) Get(_ context.Context, key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry.data, true
+}
+
+// Set stores data under key for the duration of ttl.
+func (m *MemoryCache) Set(_ context.Context, key string, data []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[key] = cacheEntry{data: data, expires: time.Now().Add(ttl)}
+}
+
+// Entries implements [CacheInspector].
+func (m *MemoryCache) Entries(context.Context) ([]CacheEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries := make([]CacheEntry, 0, len(m.entries))
+	for key, entry := range m.entries {
+		entries = append(entries, CacheEntry{Key: key, Expires: entry.expires, Size: len(entry.data)})
+	}
+	return entries, nil
+}
+
+// Delete implements [CacheInspector].
+func (m *MemoryCache) Delete(_ context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, key := range keys {
+		delete(m.entries, key)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package diyanet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// CacheInspector is implemented by caches whose entries can be listed and deleted,
+// enabling [Client.CacheEntries] and [Client.InvalidateCache].
+type CacheInspector interface {
+	Cache
+	// Entries returns all entries, including expired ones not yet removed.
+	Entries(ctx context.Context) ([]CacheEntry, error)
+	// Delete removes the entries stored under the given keys.
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// CacheEntry describes an entry of a [CacheInspector].
+type CacheEntry struct {
+	// Key is the key of the entry, the request URL optionally followed by "#" and the language.
+	Key string
+	// Expires is the time the entry expires.
+	Expires time.Time
+	// Size is the size of the stored value in bytes.
+	Size int
+}
+
+// CacheFilter selects cache entries for [Client.InvalidateCache]. Entries must match all set fields.
+type CacheFilter struct {
+	// Endpoint selects entries of the endpoint with the given path, e.g. "api/PrayerTime/Monthly".
+	Endpoint string
+	// CityID selects the prayer times and details of the city with the given ID.
+	CityID int
+	// ExpiresBefore selects entries expiring before the given time.
+	ExpiresBefore time.Time
+	// Date selects the prayer times holding the day of the given date, e.g. yesterday to drop prayer times
+	// still showing yesterday's Isha. Since keys carry no dates, the cached days are decoded to match;
+	// only the calendar date of Date counts.
+	Date time.Time
+}
+
+// CacheStats holds the hit and miss counts of the cache of a client.
+type CacheStats struct {
+	// Hits is the number of requests served from the cache.
+	Hits int64
+	// Misses is the number of requests to cached endpoints not found in the cache.
+	Misses int64
+}
+
+// ErrCacheNotInspectable is returned if the configured cache does not implement [CacheInspector].
+var ErrCacheNotInspectable = errors.New(errorPrefix + "cache does not support inspection")
+
+// CacheEntries returns the entries of the client's cache.
+func (c Client) CacheEntries() ([]CacheEntry, error) {
+	inspector, ok := c.cache.(CacheInspector)
+	if !ok {
+		return nil, ErrCacheNotInspectable
+	}
+	return inspector.Entries(c.ctx)
+}
+
+// InvalidateCache deletes the cache entries matching the filter and returns how many were deleted.
+// The zero filter deletes all entries.
+func (c Client) InvalidateCache(filter CacheFilter) (int, error) {
+	inspector, ok := c.cache.(CacheInspector)
+	if !ok {
+		return 0, ErrCacheNotInspectable
+	}
+
+	entries, err := inspector.Entries(c.ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var keys []string
+	for _, entry := range entries {
+		if !filter.matches(entry) {
+			continue
+		}
+		if !filter.Date.IsZero() {
+			value, ok := inspector.Get(c.ctx, entry.Key)
+			if !ok || !holdsDate(value, filter.Date) {
+				continue
+			}
+		}
+		keys = append(keys, entry.Key)
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	if err := inspector.Delete(c.ctx, keys...); err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+// CacheStats returns the hit and miss counts of the client's cache since the client was created.
+func (c Client) CacheStats() CacheStats {
+	if c.stats == nil {
+		return CacheStats{}
+	}
+	return CacheStats{Hits: c.stats.hits.Load(), Misses: c.stats.misses.Load()}
+}
+
+func (f CacheFilter) matches(entry CacheEntry) bool {
+	url, _, _ := strings.Cut(entry.Key, "#")
+	path := strings.TrimPrefix(url, apiURLPrefix)
+
+	if f.Endpoint != "" {
+		endpoint := strings.Trim(f.Endpoint, "/")
+		if path != endpoint && !strings.HasPrefix(path, endpoint+"/") {
+			return false
+		}
+	}
+	if f.CityID != 0 {
+		if !strings.HasPrefix(path, "api/PrayerTime/") && !strings.HasPrefix(path, "api/Place/CityDetail/") {
+			return false
+		}
+		if path[strings.LastIndex(path, "/")+1:] != strconv.Itoa(f.CityID) {
+			return false
+		}
+	}
+	if !f.ExpiresBefore.IsZero() && !entry.Expires.Before(f.ExpiresBefore) {
+		return false
+	}
+	if !f.Date.IsZero() && !strings.HasPrefix(path, "api/PrayerTime/") {
+		return false
+	}
+	return true
+}
+
+// holdsDate reports whether the cached prayer times value holds the calendar date of date.
+func holdsDate(value []byte, date time.Time) bool {
+	if body, _, ok := decodeStale(value); ok {
+		value = body
+	}
+	var result Result[[]PrayerTime]
+	if err := json.Unmarshal(value, &result); err != nil {
+		return false
+	}
+	year, month, day := date.Date()
+	for _, pt := range result.Data {
+		if y, m, d := pt.GregorianDate.Date(); y == year && m == month && d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheStats counts cache hits and misses.
+type cacheStats struct {
+	hits, misses atomic.Int64
+}
+
+func (s *cacheStats) record(hit bool) {
+	if s == nil {
+		return
+	}
+	if hit {
+		s.hits.Add(1)
+	} else {
+		s.misses.Add(1)
+	}
+}
@@ -12,6 +12,8 @@ const apiURLCitiesByState = apiURLPrefix + "api/Place/Cities/%d"
 type City struct {
 	// client is the Diyanet Awqat Salah API client.
 	client Client
+	// state is the state the city belongs to, if known.
+	state *State
 	// Id is the unique identifier for the city.
 	Id int
 	// Code is the code of the city.
@@ -20,16 +22,21 @@ type City struct {
 	Name string
 }
 
+// City returns the city with the given ID bound to the client, for retrieving its prayer times when only
+// the ID is known. Name and Code are left empty; see [Client.GetCityDetail].
+func (c Client) City(id int) City {
+	return City{client: c, Id: id}
+}
+
 // GetCities retrieves the list of cities from the Diyanet Awqat Salah API.
 func (c Client) GetCities() ([]City, error) {
-	resp, err := c.get(apiURLCities)
+	body, err := c.get(apiURLCities)
 	if err != nil {
 		return nil, fmt.Errorf(errorPrefix+"unable to get cities: %w", err)
 	}
-	defer resp.Body.Close()
 
 	var result Result[[]City]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil, fmt.Errorf(errorPrefix+"unable to decode cities response: %w", err)
 	}
 	if !result.Ok {
@@ -46,16 +53,15 @@ func (c Client) GetCities() ([]City, error) {
 // GetCities retrieves the list of cities for a given state from the Diyanet Awqat Salah API.
 func (s State) GetCities() ([]City, error) {
 	url := fmt.Sprintf(apiURLCitiesByState, s.Id)
-	resp, err := s.client.get(url)
+	body, err := s.client.get(url)
 	if err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to get cities for state %s (%d – %s): %w",
 				s.Name, s.Id, s.Code, err)
 	}
-	defer resp.Body.Close()
 
 	var result Result[[]City]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to decode cities response for state %s (%d – %s): %w",
 				s.Name, s.Id, s.Code, err)
@@ -68,6 +74,7 @@ func (s State) GetCities() ([]City, error) {
 
 	for i := range result.Data {
 		result.Data[i].client = s.client
+		result.Data[i].state = &s
 	}
 
 	return result.Data, nil
@@ -3,10 +3,17 @@ package diyanet
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
 )
 
 const apiURLCityDetail = apiURLPrefix + "api/Place/CityDetail/%d"
 
+// cityDetailConcurrency is the number of concurrent requests made by [Client.GetCityDetails].
+const cityDetailConcurrency = 8
+
 // CityDetail represents detailed information about a city as returned by the Diyanet Awqat Salah API.
 type CityDetail struct {
 	// Id is the unique identifier for the city.
@@ -34,16 +41,15 @@ type CityDetail struct {
 // GetCityDetail retrieves detailed information about a city by its ID from the Diyanet Awqat Salah API.
 func (c City) GetCityDetail() (*CityDetail, error) {
 	url := fmt.Sprintf(apiURLCityDetail, c.Id)
-	resp, err := c.client.get(url)
+	body, err := c.client.get(url)
 	if err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to get city detail for city %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
 	}
-	defer resp.Body.Close()
 
 	var result Result[*CityDetail]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to decode city detail response for city %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
@@ -56,3 +62,99 @@ func (c City) GetCityDetail() (*CityDetail, error) {
 
 	return result.Data, nil
 }
+
+// GetCityDetail retrieves detailed information about the city with the given ID from the Diyanet Awqat Salah API.
+func (c Client) GetCityDetail(id int) (*CityDetail, error) {
+	body, err := c.get(fmt.Sprintf(apiURLCityDetail, id))
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to get city detail for city %d: %w", id, err)
+	}
+
+	var result Result[*CityDetail]
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to decode city detail response for city %d: %w", id, err)
+	}
+	if !result.Ok {
+		return nil, fmt.Errorf(errorPrefix+"API error retrieving city detail for city %d: %s", id, result.Error)
+	}
+
+	return result.Data, nil
+}
+
+// GetCityDetails concurrently retrieves detailed information about the cities with the given IDs.
+// It returns the details of all cities that could be retrieved together with the errors of those that could not,
+// both keyed by city ID.
+func (c Client) GetCityDetails(ids []int) (map[int]*CityDetail, map[int]error) {
+	var (
+		mu      sync.Mutex
+		details = make(map[int]*CityDetail, len(ids))
+		errs    = make(map[int]error)
+	)
+
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	_ = forEachConcurrently(len(unique), cityDetailConcurrency, func(i int) error {
+		id := unique[i]
+		detail, err := c.GetCityDetail(id)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs[id] = err
+		} else {
+			details[id] = detail
+		}
+		return nil
+	})
+
+	return details, errs
+}
+
+// GeographicQiblaAngleDegrees returns GeographicQiblaAngle parsed as degrees.
+func (d CityDetail) GeographicQiblaAngleDegrees() (float64, error) {
+	return parseLocalizedNumber("geographic Qibla angle", d.GeographicQiblaAngle)
+}
+
+// QiblaAngleDegrees returns QiblaAngle parsed as degrees.
+func (d CityDetail) QiblaAngleDegrees() (float64, error) {
+	return parseLocalizedNumber("Qibla angle", d.QiblaAngle)
+}
+
+// DistanceToKaabaKm returns DistanceToKaaba parsed as kilometers.
+func (d CityDetail) DistanceToKaabaKm() (float64, error) {
+	return parseLocalizedNumber("distance to Kaaba", d.DistanceToKaaba)
+}
+
+// parseLocalizedNumber parses a number that may use a comma or a period as decimal separator
+// and may carry a unit such as "°" or "km". If both separators occur, the last one is the decimal
+// separator and the other one groups thousands.
+func parseLocalizedNumber(field, s string) (float64, error) {
+	number := strings.TrimSpace(strings.TrimRightFunc(s, func(r rune) bool {
+		return !unicode.IsDigit(r)
+	}))
+
+	comma, period := strings.LastIndex(number, ","), strings.LastIndex(number, ".")
+	switch {
+	case comma >= 0 && period >= 0 && comma > period:
+		number = strings.ReplaceAll(number, ".", "")
+		number = strings.Replace(number, ",", ".", 1)
+	case comma >= 0 && period >= 0:
+		number = strings.ReplaceAll(number, ",", "")
+	case comma >= 0:
+		number = strings.Replace(number, ",", ".", 1)
+	}
+	number = strings.ReplaceAll(number, " ", "")
+
+	v, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf(errorPrefix+"invalid %s %q: %w", field, s, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,110 @@
+package diyanet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// StreamCities retrieves the list of cities from the Diyanet Awqat Salah API like [Client.GetCities],
+// but decodes the response incrementally and yields one city at a time instead of materializing the whole list.
+// Iteration stops after the first error is yielded.
+func (c Client) StreamCities() iter.Seq2[City, error] {
+	return func(yield func(City, error) bool) {
+		body, err := c.open(apiURLCities)
+		if err != nil {
+			yield(City{}, fmt.Errorf(errorPrefix+"unable to get cities: %w", err))
+			return
+		}
+		defer body.Close()
+
+		err = decodeResultStream(json.NewDecoder(body), "cities", func(dec *json.Decoder) (bool, error) {
+			var city City
+			if err := dec.Decode(&city); err != nil {
+				return false, err
+			}
+			city.client = c
+			return yield(city, nil), nil
+		})
+		if err != nil && !errors.Is(err, errStopped) {
+			yield(City{}, err)
+		}
+	}
+}
+
+// errStopped is returned by decodeResultStream if the element callback asked to stop.
+var errStopped = errors.New("stopped")
+
+// decodeResultStream decodes a [Result] envelope whose data is an array, calling element for every array item
+// with the decoder positioned at that item. element reports whether decoding should continue.
+// what names the retrieved items in error messages.
+func decodeResultStream(dec *json.Decoder, what string, element func(*json.Decoder) (bool, error)) error {
+	wrap := func(err error) error {
+		return fmt.Errorf(errorPrefix+"unable to decode %s response: %w", what, err)
+	}
+
+	if tok, err := dec.Token(); err != nil {
+		return wrap(err)
+	} else if tok != json.Delim('{') {
+		return wrap(fmt.Errorf("unexpected token %v", tok))
+	}
+
+	ok, message := false, ""
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return wrap(err)
+		}
+
+		switch tok {
+		case "success":
+			err = dec.Decode(&ok)
+		case "message":
+			err = dec.Decode(&message)
+		case "data":
+			err = decodeArrayStream(dec, element)
+		default:
+			var skip json.RawMessage
+			err = dec.Decode(&skip)
+		}
+		if errors.Is(err, errStopped) {
+			return err
+		}
+		if err != nil {
+			return wrap(err)
+		}
+	}
+
+	if !ok {
+		return fmt.Errorf(errorPrefix+"API error retrieving %s: %s", what, message)
+	}
+	return nil
+}
+
+// decodeArrayStream calls element for every item of the JSON array (or null) at the decoder's position.
+func decodeArrayStream(dec *json.Decoder, element func(*json.Decoder) (bool, error)) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('[') {
+		return fmt.Errorf("unexpected token %v", tok)
+	}
+
+	for dec.More() {
+		more, err := element(dec)
+		if err != nil {
+			return err
+		}
+		if !more {
+			return errStopped
+		}
+	}
+
+	_, err = dec.Token()
+	return err
+}
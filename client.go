@@ -1,30 +1,241 @@
 package diyanet
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
+const apiURLPlacePrefix = apiURLPrefix + "api/Place/"
+const apiURLPrayerTimeMonthlyPrefix = apiURLPrefix + "api/PrayerTime/Monthly/"
+const apiURLPrayerTimeRamadanPrefix = apiURLPrefix + "api/PrayerTime/Ramadan/"
+
 // Client is a Diyanet Awqat Salah API client.
 type Client struct {
 	// ctx is the context used for making requests.
 	ctx context.Context
 	// httpClient is the HTTP client used to make requests.
 	httpClient *http.Client
+	// retry is the retry policy applied to requests.
+	retry RetryPolicy
+	// timezones resolves the time zone of cities, if configured.
+	timezones *TimezoneResolver
+	// geocoder resolves addresses and coordinates to places, if configured.
+	geocoder Geocoder
+	// cache holds responses of cached endpoints; it is shared by all copies of the client.
+	cache Cache
+	// stats counts cache hits and misses; it is shared by all copies of the client.
+	stats *cacheStats
+	// placeCacheTTL is the time place responses are cached for; zero disables caching.
+	placeCacheTTL time.Duration
+	// prayerTimeCacheTTL is the time monthly and Ramadan prayer times are cached for; zero disables caching.
+	prayerTimeCacheTTL time.Duration
+	// dailyContentLocation is the time zone whose days delimit the daily content.
+	dailyContentLocation *time.Location
+	// staleWhileRevalidate is how long expired responses are still served while being refreshed.
+	staleWhileRevalidate time.Duration
+	// revalidating holds the cache keys being refreshed in the background; it is shared by all copies of the client.
+	revalidating *sync.Map
+	// onTimetableChange receives the days that changed when cached monthly prayer times are refreshed.
+	onTimetableChange func(TimetableChange)
+	// offline serves all requests from the cache only.
+	offline bool
+	// prefetchTTL is the minimum time responses are cached for while prefetching.
+	prefetchTTL time.Duration
+	// language is the preferred language of the content, sent as Accept-Language header if set.
+	language Language
 }
 
 // NewClient creates a new Diyanet Awqat Salah API client using the provided configuration.
 func (c Config) NewClient(ctx context.Context) Client {
+	placeCacheTTL := c.PlaceCacheTTL
+	switch {
+	case placeCacheTTL == 0:
+		placeCacheTTL = DefaultPlaceCacheTTL
+	case placeCacheTTL < 0:
+		placeCacheTTL = 0
+	}
+	prayerTimeCacheTTL := c.PrayerTimeCacheTTL
+	switch {
+	case prayerTimeCacheTTL == 0:
+		prayerTimeCacheTTL = DefaultPrayerTimeCacheTTL
+	case prayerTimeCacheTTL < 0:
+		prayerTimeCacheTTL = 0
+	}
+
 	return Client{
-		ctx:        ctx,
-		httpClient: c.HTTPClient(ctx),
+		ctx:                  ctx,
+		httpClient:           c.HTTPClient(ctx),
+		retry:                c.Retry,
+		timezones:            c.Timezones,
+		geocoder:             c.Geocoder,
+		cache:                c.cache(),
+		stats:                new(cacheStats),
+		placeCacheTTL:        placeCacheTTL,
+		prayerTimeCacheTTL:   prayerTimeCacheTTL,
+		dailyContentLocation: c.DailyContentLocation,
+		language:             c.Language,
+		staleWhileRevalidate: max(c.StaleWhileRevalidate, 0),
+		revalidating:         new(sync.Map),
+		offline:              c.OfflineOnly,
+		onTimetableChange:    c.OnTimetableChange,
+	}
+}
+
+// get returns the body of the response to a GET request for url,
+// served from the cache if the endpoint is cached and a fresh copy is available.
+func (c Client) get(url string) ([]byte, error) {
+	if c.offline {
+		return c.offlineGet(url)
 	}
+
+	ttl := c.cacheTTL(url)
+	if ttl > 0 {
+		if body, ok := c.lookup(url, ttl); ok {
+			return body, nil
+		}
+	}
+
+	return c.fetch(url, ttl)
 }
 
-func (c Client) get(url string) (resp *http.Response, err error) {
+// fetch requests url and stores a successful response in the cache for ttl if positive.
+func (c Client) fetch(url string, ttl time.Duration) ([]byte, error) {
+	if c.httpClient == nil {
+		return nil, errors.New("no client attached; create places via a Client or see PlaceTree.Attach")
+	}
+
+	resp, err := c.retry.do(c.ctx, c.httpClient, func() (*http.Request, error) {
+		return c.newRequest(url)
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if ttl > 0 && resp.StatusCode >= 200 && resp.StatusCode < 300 && isSuccessful(body) {
+		previous, _ := c.peek(url)
+		c.store(url, body, ttl)
+		c.notifyTimetableChanges(url, previous, body)
+	}
+	return body, nil
+}
+
+// open returns the body of the response to a GET request for url for streaming.
+// A cached copy is served if available, but a fetched body is not added to the cache since it is not buffered.
+// The caller must close the returned body.
+func (c Client) open(url string) (io.ReadCloser, error) {
+	if c.offline {
+		body, err := c.offlineGet(url)
+		if err != nil {
+			return nil, err
+		}
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	if ttl := c.cacheTTL(url); ttl > 0 {
+		if body, ok := c.lookup(url, ttl); ok {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	if c.httpClient == nil {
+		return nil, errors.New("no client attached; create places via a Client or see PlaceTree.Attach")
+	}
+
+	resp, err := c.retry.do(c.ctx, c.httpClient, func() (*http.Request, error) {
+		return c.newRequest(url)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// WithLanguage returns a copy of the client requesting content in the given language.
+// The copy shares the cache of the client.
+func (c Client) WithLanguage(lang Language) Client {
+	c.language = lang
+	return c
+}
+
+// WithContext returns a copy of the client making its requests in ctx, e.g. to bound them with a deadline.
+// The copy shares the cache of the client.
+func (c Client) WithContext(ctx context.Context) Client {
+	c.ctx = ctx
+	return c
+}
+
+// newRequest creates a GET request for url, asking for content in the client's language if set.
+func (c Client) newRequest(url string) (*http.Request, error) {
 	req, err := http.NewRequestWithContext(c.ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
-	return c.httpClient.Do(req)
+	if c.language != "" {
+		req.Header.Set("Accept-Language", string(c.language))
+	}
+	return req, nil
+}
+
+// cacheKey returns the key under which the response for url is cached.
+// Responses in different languages are cached separately.
+func (c Client) cacheKey(url string) string {
+	if c.language == "" {
+		return url
+	}
+	return url + "#" + string(c.language)
+}
+
+// cacheTTL returns how long the response for url may be cached; zero means not at all.
+func (c Client) cacheTTL(url string) time.Duration {
+	if c.cache == nil {
+		return 0
+	}
+	switch {
+	case strings.HasPrefix(url, apiURLPlacePrefix):
+		return max(c.placeCacheTTL, c.prefetchTTL)
+	case strings.HasPrefix(url, apiURLPrayerTimeMonthlyPrefix), strings.HasPrefix(url, apiURLPrayerTimeRamadanPrefix):
+		return max(c.prayerTimeCacheTTL, c.prefetchTTL)
+	case url == apiURLDailyContent:
+		now := time.Now().In(c.dayLocation())
+		return time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location()).Sub(now)
+	}
+	return 0
+}
+
+// dayLocation returns the time zone whose calendar days delimit the daily content.
+func (c Client) dayLocation() *time.Location {
+	if c.dailyContentLocation != nil {
+		return c.dailyContentLocation
+	}
+	return turkeyLocation()
+}
+
+// turkeyLocation returns the time zone of Türkiye, falling back to its fixed offset
+// if the time zone database is unavailable.
+var turkeyLocation = sync.OnceValue(func() *time.Location {
+	loc, err := time.LoadLocation("Europe/Istanbul")
+	if err != nil {
+		return time.FixedZone("TRT", 3*60*60)
+	}
+	return loc
+})
+
+// isSuccessful reports whether body is a [Result] envelope indicating success.
+func isSuccessful(body []byte) bool {
+	var result Result[json.RawMessage]
+	return json.Unmarshal(body, &result) == nil && result.Ok
 }
@@ -0,0 +1,61 @@
+package diyanet
+
+import "time"
+
+// PrayerDelta is the difference of one prayer time on one date between two timetables.
+type PrayerDelta struct {
+	// Date is the date both prayer times belong to.
+	Date time.Time
+	// Prayer is the compared prayer.
+	Prayer PrayerName
+	// Reference is the prayer's instant in the reference timetable.
+	Reference time.Time
+	// Other is the prayer's instant in the compared timetable.
+	Other time.Time
+	// Delta is the absolute time difference Other − Reference.
+	Delta time.Duration
+	// ClockDelta is the difference of the local wall clock times, ignoring the time zones.
+	// It answers questions like "how much later is Isha on the clock in Berlin than in Ankara".
+	ClockDelta time.Duration
+}
+
+// CompareTimetables aligns two timetables, e.g. of different cities, by date and returns the
+// per-prayer differences of other against reference for every date present in both.
+// Days whose prayer times cannot be parsed are skipped.
+func CompareTimetables(reference, other Timetable) []PrayerDelta {
+	var deltas []PrayerDelta
+	for _, ref := range reference {
+		cmp, ok := other.ByDate(ref.GregorianDate)
+		if !ok {
+			continue
+		}
+
+		refDay, err := ref.Day()
+		if err != nil {
+			continue
+		}
+		cmpDay, err := cmp.Day()
+		if err != nil {
+			continue
+		}
+
+		for name, refTime := range refDay.All() {
+			cmpTime := cmpDay.Times[name]
+			deltas = append(deltas, PrayerDelta{
+				Date:       ref.GregorianDate,
+				Prayer:     name,
+				Reference:  refTime,
+				Other:      cmpTime,
+				Delta:      cmpTime.Sub(refTime),
+				ClockDelta: wallClock(cmpTime).Sub(wallClock(refTime)),
+			})
+		}
+	}
+
+	return deltas
+}
+
+// wallClock returns the wall clock reading of t as if it were in UTC.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
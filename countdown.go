@@ -0,0 +1,50 @@
+package diyanet
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// Countdown returns an iterator emitting the next prayer and the remaining duration until it begins,
+// once immediately and then every interval as well as at every prayer time.
+// The iteration ends when ctx is done or when the timetable has no upcoming prayer left,
+// in which case [ErrNoUpcomingPrayer] is yielded as the final error.
+// If clock is nil, [SystemClock] is used.
+func (t Timetable) Countdown(ctx context.Context, clock Clock, interval time.Duration) iter.Seq2[UpcomingPrayer, error] {
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	return func(yield func(UpcomingPrayer, error) bool) {
+		prayers, err := sortedPrayers(t)
+		if err != nil {
+			yield(UpcomingPrayer{}, err)
+			return
+		}
+
+		for {
+			now := clock.Now()
+			i := firstPrayerAfter(prayers, now)
+			if i == len(prayers) {
+				yield(UpcomingPrayer{}, ErrNoUpcomingPrayer)
+				return
+			}
+
+			next := UpcomingPrayer{Prayer: prayers[i], Remaining: prayers[i].Time.Sub(now)}
+			if !yield(next, nil) {
+				return
+			}
+
+			wait := next.Remaining
+			if interval > 0 && interval < wait {
+				wait = interval
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-clock.After(wait):
+			}
+		}
+	}
+}
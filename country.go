@@ -21,14 +21,13 @@ type Country struct {
 
 // GetCountries retrieves the list of countries from the Diyanet Awqat Salah API.
 func (c Client) GetCountries() ([]Country, error) {
-	resp, err := c.get(apiURLCountries)
+	body, err := c.get(apiURLCountries)
 	if err != nil {
 		return nil, fmt.Errorf(errorPrefix+"unable to get countries: %w", err)
 	}
-	defer resp.Body.Close()
 
 	var result Result[[]Country]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil, fmt.Errorf(errorPrefix+"unable to decode countries response: %w", err)
 	}
 	if !result.Ok {
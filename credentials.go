@@ -0,0 +1,119 @@
+package diyanet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+const credentialsErrorPrefix = errorPrefix + "unable to load credentials: "
+
+// Credentials holds the email address and password used to log in to the Diyanet Awqat Salah API.
+type Credentials struct {
+	// Email is the user's email address used for authentication.
+	Email string `json:"email"`
+	// Password is the user's password used for authentication.
+	Password string `json:"password"`
+}
+
+// CredentialProvider supplies the credentials used to log in to the Diyanet Awqat Salah API.
+// It is consulted on every login, so rotated secrets are picked up without restarting.
+//
+// Secret managers such as HashiCorp Vault or AWS Secrets Manager can be integrated
+// by implementing this interface or by wrapping a function in [CredentialProviderFunc].
+type CredentialProvider interface {
+	// Credentials returns the credentials to log in with.
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialProviderFunc is an adapter to allow the use of ordinary functions as [CredentialProvider].
+type CredentialProviderFunc func(ctx context.Context) (Credentials, error)
+
+// Credentials implements [CredentialProvider].
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// EnvCredentials reads the credentials from environment variables.
+type EnvCredentials struct {
+	// EmailVar is the name of the variable holding the email address. Defaults to DIYANET_EMAIL.
+	EmailVar string
+	// PasswordVar is the name of the variable holding the password. Defaults to DIYANET_PASSWORD.
+	PasswordVar string
+}
+
+// Credentials implements [CredentialProvider].
+func (e EnvCredentials) Credentials(context.Context) (Credentials, error) {
+	emailVar := e.EmailVar
+	if emailVar == "" {
+		emailVar = "DIYANET_EMAIL"
+	}
+	passwordVar := e.PasswordVar
+	if passwordVar == "" {
+		passwordVar = "DIYANET_PASSWORD"
+	}
+
+	email, ok := os.LookupEnv(emailVar)
+	if !ok {
+		return Credentials{}, fmt.Errorf(credentialsErrorPrefix+"environment variable %s is not set", emailVar)
+	}
+	password, ok := os.LookupEnv(passwordVar)
+	if !ok {
+		return Credentials{}, fmt.Errorf(credentialsErrorPrefix+"environment variable %s is not set", passwordVar)
+	}
+
+	return Credentials{Email: email, Password: password}, nil
+}
+
+// FileCredentials reads the credentials from a JSON file of the form
+// {"email": "...", "password": "..."}.
+type FileCredentials struct {
+	// Path is the path of the JSON file.
+	Path string
+}
+
+// Credentials implements [CredentialProvider].
+func (f FileCredentials) Credentials(context.Context) (Credentials, error) {
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		return Credentials{}, fmt.Errorf(credentialsErrorPrefix+"%w", err)
+	}
+
+	var creds Credentials
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return Credentials{}, fmt.Errorf(credentialsErrorPrefix+"failed to decode %s: %w", f.Path, err)
+	}
+
+	return creds, nil
+}
+
+// KeychainCredentials reads the password from the operating system's credential store:
+// the login keychain on macOS, the Secret Service (via secret-tool) on Linux and other
+// Unix systems, and the Credential Manager on Windows.
+type KeychainCredentials struct {
+	// Service is the name under which the password is stored
+	// (the service on macOS and Unix, the target name on Windows).
+	Service string
+	// Email is the account the password belongs to and the email address used for login.
+	Email string
+}
+
+// Credentials implements [CredentialProvider].
+func (k KeychainCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	password, err := readKeychain(ctx, k.Service, k.Email)
+	if err != nil {
+		return Credentials{}, fmt.Errorf(credentialsErrorPrefix+"keychain lookup for %s failed: %w", k.Service, err)
+	}
+
+	return Credentials{Email: k.Email, Password: password}, nil
+}
+
+// credentials returns the credentials to log in with,
+// preferring the configured provider over the static email and password.
+func (c Config) credentials(ctx context.Context) (Credentials, error) {
+	if c.Credentials == nil {
+		return Credentials{Email: c.Email, Password: c.Password}, nil
+	}
+	return c.Credentials.Credentials(ctx)
+}
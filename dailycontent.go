@@ -30,14 +30,13 @@ type DailyContent struct {
 
 // GetDailyContent retrieves the daily content from the Diyanet Awqat Salah API.
 func (c Client) GetDailyContent() (*DailyContent, error) {
-	resp, err := c.get(apiURLDailyContent)
+	body, err := c.get(apiURLDailyContent)
 	if err != nil {
 		return nil, fmt.Errorf(errorPrefix+"unable to get daily content: %w", err)
 	}
-	defer resp.Body.Close()
 
 	var result Result[*DailyContent]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil, fmt.Errorf(errorPrefix+"unable to decode daily content response: %w", err)
 	}
 	if !result.Ok {
@@ -0,0 +1,82 @@
+package diyanet
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// dailyContentPollDelay is how long after midnight the next day's content is requested,
+// allowing for clock skew and for the API switching over late.
+const dailyContentPollDelay = 15 * time.Minute
+
+// DailyContentArchive collects [DailyContent] for every day of the year, indexed by DayOfYear (1–366).
+// Index 0 is unused. Missing days are nil. The archive can be stored with [encoding/json] and reloaded later
+// to resume collecting.
+type DailyContentArchive []*DailyContent
+
+// NewDailyContentArchive returns an empty archive.
+func NewDailyContentArchive() DailyContentArchive {
+	return make(DailyContentArchive, 367)
+}
+
+// Add stores the content under its DayOfYear, replacing any previous content for that day.
+func (a DailyContentArchive) Add(content *DailyContent) error {
+	if content == nil || content.DayOfYear < 1 || content.DayOfYear >= len(a) {
+		return errors.New(errorPrefix + "daily content without valid day of year")
+	}
+	a[content.DayOfYear] = content
+	return nil
+}
+
+// Day returns the content for the given day of the year, if collected.
+func (a DailyContentArchive) Day(dayOfYear int) (*DailyContent, bool) {
+	if dayOfYear < 1 || dayOfYear >= len(a) || a[dayOfYear] == nil {
+		return nil, false
+	}
+	return a[dayOfYear], true
+}
+
+// Missing returns the days of the year (1–365) for which no content has been collected yet.
+// Day 366 is kept if served in a leap year but not reported as missing.
+func (a DailyContentArchive) Missing() []int {
+	var missing []int
+	for day := 1; day <= 365; day++ {
+		if _, ok := a.Day(day); !ok {
+			missing = append(missing, day)
+		}
+	}
+	return missing
+}
+
+// CollectDailyContent fills the archive by polling the daily content once a day, shortly after midnight
+// in [Config.DailyContentLocation], since the Diyanet Awqat Salah API only serves the content of the current day.
+// It returns when the archive is complete, which takes a year when starting empty, when ctx is done,
+// or when a request fails; the archive keeps everything collected so far and can be passed again to resume.
+// If clock is nil, [SystemClock] is used.
+func (c Client) CollectDailyContent(ctx context.Context, clock Clock, archive DailyContentArchive) error {
+	if clock == nil {
+		clock = SystemClock
+	}
+	loc := c.dayLocation()
+
+	for len(archive.Missing()) > 0 {
+		content, err := c.GetDailyContent()
+		if err != nil {
+			return err
+		}
+		if err := archive.Add(content); err != nil {
+			return err
+		}
+
+		now := clock.Now().In(loc)
+		next := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, loc).Add(dailyContentPollDelay)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(next.Sub(now)):
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package diyanet
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DailyContentFeed builds RSS 2.0 and Atom feeds from the daily content of several days,
+// for syndicating the daily verse, hadith and prayer.
+type DailyContentFeed struct {
+	// Title is the title of the feed.
+	Title string
+	// Link is the URL of the website publishing the feed; it is also used to build entry links.
+	Link string
+	// Description describes the feed.
+	Description string
+	// Language is the language of the content, e.g. "tr". Defaults to "tr".
+	Language string
+	// Items holds the daily content of the feed; they are written newest first.
+	Items []DailyContentFeedItem
+}
+
+// DailyContentFeedItem is the daily content of one day within a [DailyContentFeed].
+type DailyContentFeedItem struct {
+	// Date is the day the content was published for.
+	Date time.Time
+	// Content is the daily content.
+	Content DailyContent
+}
+
+// Title returns the title of the item, consisting of the date and the verse's reference.
+func (i DailyContentFeedItem) Title() string {
+	title := i.Date.Format("2006-01-02")
+	if source := strings.TrimSpace(i.Content.VerseSource); source != "" {
+		title += " " + source
+	}
+	return title
+}
+
+// Text returns the verse, hadith and prayer of the item with their sources as plain text.
+func (i DailyContentFeedItem) Text() string {
+	var parts []string
+	for _, part := range [][2]string{
+		{i.Content.Verse, i.Content.VerseSource},
+		{i.Content.Hadith, i.Content.HadithSource},
+		{i.Content.Pray, i.Content.PraySource},
+	} {
+		text := strings.TrimSpace(strings.TrimSpace(part[0]) + "\n" + strings.TrimSpace(part[1]))
+		if text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// id returns a stable, unique identifier of the item as a tag URI (RFC 4151).
+func (i DailyContentFeedItem) id() string {
+	return fmt.Sprintf("tag:awqatsalah.diyanet.gov.tr,%s:dailycontent/%d", dateKey(i.Date), i.Content.DayOfYear)
+}
+
+func (i DailyContentFeedItem) link(base string) string {
+	if base == "" {
+		return ""
+	}
+	return strings.TrimSuffix(base, "/") + "/#" + dateKey(i.Date)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link,omitempty"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Lang    string      `xml:"xml:lang,attr,omitempty"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    *atomLink   `xml:"link,omitempty"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    *atomLink   `xml:"link,omitempty"`
+	Content atomContent `xml:"content"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// WriteRSS writes the feed as RSS 2.0.
+func (f DailyContentFeed) WriteRSS(w io.Writer) error {
+	items := f.sortedItems()
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       f.Title,
+			Link:        f.Link,
+			Description: f.Description,
+			Language:    f.language(),
+		},
+	}
+	if len(items) > 0 {
+		feed.Channel.LastBuildDate = items[0].Date.Format(time.RFC1123Z)
+	}
+	for _, item := range items {
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       item.Title(),
+			Link:        item.link(f.Link),
+			Description: item.Text(),
+			GUID:        rssGUID{Value: item.id()},
+			PubDate:     item.Date.Format(time.RFC1123Z),
+		})
+	}
+
+	return writeXML(w, feed)
+}
+
+// WriteAtom writes the feed as Atom (RFC 4287).
+func (f DailyContentFeed) WriteAtom(w io.Writer) error {
+	items := f.sortedItems()
+	feed := atomFeed{
+		Lang:   f.language(),
+		ID:     "tag:awqatsalah.diyanet.gov.tr,2000:dailycontent",
+		Title:  f.Title,
+		Author: atomAuthor{Name: "Diyanet İşleri Başkanlığı"},
+	}
+	if f.Link != "" {
+		feed.ID = f.Link
+		feed.Link = &atomLink{Href: f.Link}
+	}
+	if len(items) > 0 {
+		feed.Updated = items[0].Date.Format(time.RFC3339)
+	} else {
+		feed.Updated = time.Now().Format(time.RFC3339)
+	}
+	for _, item := range items {
+		entry := atomEntry{
+			ID:      item.id(),
+			Title:   item.Title(),
+			Updated: item.Date.Format(time.RFC3339),
+			Content: atomContent{Type: "text", Value: item.Text()},
+		}
+		if link := item.link(f.Link); link != "" {
+			entry.Link = &atomLink{Href: link}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return writeXML(w, feed)
+}
+
+func (f DailyContentFeed) language() string {
+	if f.Language == "" {
+		return "tr"
+	}
+	return f.Language
+}
+
+// sortedItems returns the items newest first.
+func (f DailyContentFeed) sortedItems() []DailyContentFeedItem {
+	items := slices.Clone(f.Items)
+	slices.SortStableFunc(items, func(a, b DailyContentFeedItem) int {
+		return b.Date.Compare(a.Date)
+	})
+	return items
+}
+
+func writeXML(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,86 @@
+package diyanet
+
+import (
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// dailyContentHTML lays out the daily content as an HTML fragment.
+var dailyContentHTML = htmltemplate.Must(htmltemplate.New("dailycontent").Parse(
+	`<article class="daily-content">
+{{- range .}}
+  <section class="daily-content-{{.Class}}">
+    <h2>{{.Heading}}</h2>
+    <blockquote>{{.Text}}</blockquote>
+    {{- if .Source}}
+    <cite>{{.Source}}</cite>
+    {{- end}}
+  </section>
+{{- end}}
+</article>
+`))
+
+// dailyContentMarkdown lays out the daily content as Markdown; texts are escaped by markdownEscape.
+var dailyContentMarkdown = template.Must(template.New("dailycontent").Funcs(template.FuncMap{
+	"escape": markdownEscape,
+}).Parse(
+	`{{range $i, $part := .}}{{if $i}}
+{{end}}## {{.Heading}}
+
+{{range .Lines}}> {{escape .}}
+{{end}}{{if .Source}}
+*{{escape .Source}}*
+{{end}}{{end}}`))
+
+// dailyContentPart is a verse, hadith or prayer as passed to the templates.
+type dailyContentPart struct {
+	Class   string
+	Heading string
+	Text    string
+	Source  string
+}
+
+// Lines returns the text split into lines, for quoting every line in Markdown.
+func (p dailyContentPart) Lines() []string {
+	return strings.Split(p.Text, "\n")
+}
+
+// parts returns the non-empty verse, hadith and prayer of the content.
+func (d DailyContent) parts() []dailyContentPart {
+	var parts []dailyContentPart
+	for _, part := range []dailyContentPart{
+		{Class: "verse", Heading: "Ayet", Text: d.Verse, Source: d.VerseSource},
+		{Class: "hadith", Heading: "Hadis", Text: d.Hadith, Source: d.HadithSource},
+		{Class: "prayer", Heading: "Dua", Text: d.Pray, Source: d.PraySource},
+	} {
+		part.Text, part.Source = strings.TrimSpace(part.Text), strings.TrimSpace(part.Source)
+		if part.Text != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+// RenderHTML writes the verse, hadith and prayer with their sources as an HTML fragment.
+// Texts are escaped; the elements carry "daily-content-*" classes for styling.
+func (d DailyContent) RenderHTML(w io.Writer) error {
+	return dailyContentHTML.Execute(w, d.parts())
+}
+
+// RenderMarkdown writes the verse, hadith and prayer with their sources as Markdown,
+// with texts quoted and Markdown syntax in them escaped.
+func (d DailyContent) RenderMarkdown(w io.Writer) error {
+	return dailyContentMarkdown.Execute(w, d.parts())
+}
+
+// markdownEscaper escapes characters with a meaning in Markdown.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`, "~", `\~`,
+)
+
+func markdownEscape(s string) string {
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,103 @@
+package diyanet
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimetableChange reports the prayer times of one day that changed between two versions of a timetable.
+type TimetableChange struct {
+	// CityId is the ID of the city.
+	CityId int
+	// Date is the changed day.
+	Date time.Time
+	// Changes holds the prayers whose time changed, with the old version as reference.
+	Changes []PrayerDelta
+}
+
+// DiffTimetables returns the days present in both timetables whose prayer times differ,
+// in chronological order. Days only present in one of the timetables are not reported.
+func DiffTimetables(old, updated Timetable) []TimetableChange {
+	var changes []TimetableChange
+	for _, delta := range CompareTimetables(old, updated) {
+		if delta.Delta == 0 {
+			continue
+		}
+
+		if n := len(changes); n == 0 || dateKey(changes[n-1].Date) != dateKey(delta.Date) {
+			pt, _ := updated.ByDate(delta.Date)
+			changes = append(changes, TimetableChange{CityId: pt.CityId, Date: delta.Date})
+		}
+		last := &changes[len(changes)-1]
+		last.Changes = append(last.Changes, delta)
+	}
+	return changes
+}
+
+// RefreshPrayerTimes requests the monthly prayer times of the city, bypassing the cache, and returns
+// the days that changed compared with the cached copy. If nothing was cached, no changes are returned.
+// The refreshed prayer times are cached if monthly prayer times are cached at all (see [Config.PrayerTimeCacheTTL]),
+// and [Config.OnTimetableChange] is notified of the changes.
+func (c Client) RefreshPrayerTimes(cityID int) ([]TimetableChange, error) {
+	url := fmt.Sprintf(apiURLPrayerTimeMonthly, cityID)
+	previous, _ := c.peek(url)
+
+	body, err := c.fetch(url, c.cacheTTL(url))
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to refresh monthly prayer time for city %d: %w", cityID, err)
+	}
+	updated, ok := decodeTimetable(body, cityID)
+	if !ok {
+		return nil, fmt.Errorf(errorPrefix+"unable to decode monthly prayer time response for city %d", cityID)
+	}
+	if previous == nil {
+		return nil, nil
+	}
+
+	old, ok := decodeTimetable(previous, cityID)
+	if !ok {
+		return nil, nil
+	}
+	return DiffTimetables(old, updated), nil
+}
+
+// notifyTimetableChanges reports the days that changed between the previous and the current
+// response for url to the configured callback, if url is a monthly prayer time request.
+func (c Client) notifyTimetableChanges(url string, previous, current []byte) {
+	if c.onTimetableChange == nil || previous == nil || !strings.HasPrefix(url, apiURLPrayerTimeMonthlyPrefix) {
+		return
+	}
+	cityID, err := strconv.Atoi(strings.TrimPrefix(url, apiURLPrayerTimeMonthlyPrefix))
+	if err != nil {
+		return
+	}
+
+	old, ok := decodeTimetable(previous, cityID)
+	if !ok {
+		return
+	}
+	updated, ok := decodeTimetable(current, cityID)
+	if !ok {
+		return
+	}
+
+	for _, change := range DiffTimetables(old, updated) {
+		c.onTimetableChange(change)
+	}
+}
+
+// decodeTimetable decodes a prayer time response into a timetable in the zone of the API's GMT offset.
+func decodeTimetable(body []byte, cityID int) (Timetable, bool) {
+	var result Result[[]PrayerTime]
+	if err := json.Unmarshal(body, &result); err != nil || !result.Ok {
+		return nil, false
+	}
+	for i := range result.Data {
+		result.Data[i].fixGregorianDate(nil)
+		result.Data[i].CityId = cityID
+	}
+	return NewTimetable(result.Data), true
+}
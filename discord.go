@@ -0,0 +1,99 @@
+package diyanet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Colors of the Discord embeds, by kind of notification.
+const (
+	discordPrayerColor       = 0x1f8b4c
+	discordDailyContentColor = 0x2f6fb0
+	discordColor             = 0x99aab5
+)
+
+// Limits of a Discord embed in characters.
+const (
+	discordMaxTitle       = 256
+	discordMaxDescription = 4096
+)
+
+// DiscordNotifier posts notifications as embeds to Discord channels through webhooks,
+// see https://discord.com/developers/docs/resources/webhook.
+//
+// Create a webhook per channel in the channel's settings under Integrations.
+type DiscordNotifier struct {
+	// Webhooks are the channels notifications are posted to, each with its own preferences.
+	Webhooks []DiscordWebhook
+	// Username, if set, overrides the name of the webhook shown with the posts.
+	Username string
+	// HTTPClient is the HTTP client used to make requests. Defaults to [http.DefaultClient].
+	HTTPClient *http.Client
+}
+
+// DiscordWebhook is a channel a [DiscordNotifier] posts to.
+type DiscordWebhook struct {
+	// URL is the webhook URL, e.g. "https://discord.com/api/webhooks/<id>/<token>". It must be kept secret.
+	URL string
+	// Filter selects the notifications posted to the channel.
+	Filter NotificationFilter
+}
+
+// discordEmbed is a rich embed of a Discord message.
+type discordEmbed struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	Color       int            `json:"color"`
+	Timestamp   string         `json:"timestamp,omitempty"`
+	Fields      []discordField `json:"fields,omitempty"`
+}
+
+type discordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+// Send posts the notification as an embed to every webhook whose filter selects it. Prayer notifications
+// carry the city, prayer and time as fields. Failed webhooks do not prevent posting to the others;
+// their errors are returned joined.
+func (d DiscordNotifier) Send(ctx context.Context, n Notification) error {
+	embed := discordEmbed{
+		Title:       truncate(n.Title, discordMaxTitle),
+		Description: truncate(n.Text, discordMaxDescription),
+		Color:       discordColor,
+	}
+	switch {
+	case n.Event != nil:
+		embed.Color = discordPrayerColor
+		embed.Timestamp = n.Event.Prayer.Time.Format(time.RFC3339)
+		embed.Fields = []discordField{
+			{Name: "Prayer", Value: n.Event.Prayer.Name.String(), Inline: true},
+			{Name: "Time", Value: n.Event.Prayer.Time.Format("15:04"), Inline: true},
+		}
+		if n.Event.City.Name != "" {
+			embed.Fields = append(embed.Fields, discordField{Name: "City", Value: n.Event.City.Name, Inline: true})
+		}
+	case n.DailyContent != nil:
+		embed.Color = discordDailyContentColor
+	}
+
+	payload := map[string]any{"embeds": []discordEmbed{embed}}
+	if d.Username != "" {
+		payload["username"] = d.Username
+	}
+
+	var errs []error
+	for i, webhook := range d.Webhooks {
+		if !webhook.Filter.Matches(n) {
+			continue
+		}
+		if err := postJSON(ctx, d.HTTPClient, "discord", webhook.URL, nil, payload, nil); err != nil {
+			errs = append(errs, fmt.Errorf("%w (webhook %d)", err, i))
+		}
+	}
+	return errors.Join(errs...)
+}
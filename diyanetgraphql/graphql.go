@@ -0,0 +1,395 @@
+// Package diyanetgraphql provides a GraphQL endpoint over the Diyanet Awqat Salah API, so that web frontends
+// fetch exactly the fields they need in one round trip, e.g. a city with its detail and prayer times:
+//
+//	http.Handle("/graphql", diyanetgraphql.New(client))
+//
+//	query {
+//	  city(id: 9541) {
+//	    name
+//	    detail { qiblaAngle }
+//	    prayerTimes(timeZone: "Europe/Istanbul") { date fajr maghrib }
+//	  }
+//	  dailyContent { verse verseSource }
+//	}
+//
+// The schema is returned by [Schema]. Only the selected fields are requested from the API.
+package diyanetgraphql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	diyanet "github.com/abduelhamit/DiyanetAwqatSalahAPI"
+)
+
+const errorPrefix = "diyanet: "
+
+// Limits of a query, so that one query cannot fan out to an unbounded number of requests to the API.
+const (
+	// maxDepth is the maximum nesting depth of a query.
+	maxDepth = 5
+	// maxQueryLength is the maximum length of a query in bytes, bounding the number of aliased fields.
+	maxQueryLength = 4096
+	// maxCities is the maximum number of cities returned by the cities field.
+	maxCities = 20
+)
+
+//go:embed schema.graphql
+var schema string
+
+// Schema returns the GraphQL schema served by the handler of [New], in the schema definition language.
+func Schema() string {
+	return schema
+}
+
+// New returns an [http.Handler] answering GraphQL requests, POSTed as JSON, with the client, whose cache
+// configuration (see [diyanet.Config]) determines how often the Diyanet Awqat Salah API is asked.
+func New(client diyanet.Client) http.Handler {
+	return &relay.Handler{Schema: graphql.MustParseSchema(schema, &query{client: client},
+		graphql.MaxDepth(maxDepth), graphql.MaxQueryLength(maxQueryLength))}
+}
+
+// query resolves the Query type.
+type query struct {
+	client diyanet.Client
+}
+
+// City and the other resolvers of the query make their requests in the context of the GraphQL request;
+// the cities they return carry it on to their fields.
+func (q *query) City(ctx context.Context, args struct{ ID int32 }) *city {
+	return newCity(q.client.WithContext(ctx).City(int(args.ID)))
+}
+
+func (q *query) Cities(ctx context.Context, args struct {
+	Search  string
+	Country *string
+	Limit   *int32
+}) ([]*city, error) {
+	var opts diyanet.FindCityOptions
+	if args.Country != nil {
+		opts.Country = *args.Country
+	}
+	if args.Limit != nil && *args.Limit > 0 {
+		opts.Limit = min(int(*args.Limit), maxCities)
+	}
+	matches, err := q.client.WithContext(ctx).FindCity(args.Search, opts)
+	if err != nil {
+		return nil, err
+	}
+	cities := make([]*city, len(matches))
+	for i, match := range matches {
+		cities[i] = newCity(match.City)
+	}
+	return cities, nil
+}
+
+func (q *query) DailyContent(ctx context.Context) (*dailyContent, error) {
+	content, err := q.client.WithContext(ctx).GetDailyContent()
+	if err != nil {
+		return nil, err
+	}
+	return &dailyContent{content}, nil
+}
+
+// city resolves the City type. The detail is requested once, when first needed.
+type city struct {
+	city   diyanet.City
+	detail func() (*diyanet.CityDetail, error)
+}
+
+func newCity(c diyanet.City) *city {
+	return &city{city: c, detail: sync.OnceValues(c.GetCityDetail)}
+}
+
+func (c *city) ID() int32 {
+	return int32(c.city.Id)
+}
+
+func (c *city) Code() (string, error) {
+	if c.city.Code != "" {
+		return c.city.Code, nil
+	}
+	detail, err := c.detail()
+	if err != nil {
+		return "", err
+	}
+	return detail.Code, nil
+}
+
+func (c *city) Name() (string, error) {
+	if c.city.Name != "" {
+		return c.city.Name, nil
+	}
+	detail, err := c.detail()
+	if err != nil {
+		return "", err
+	}
+	return detail.LocalizedName(diyanet.Turkish), nil
+}
+
+func (c *city) Detail() (*cityDetail, error) {
+	detail, err := c.detail()
+	if err != nil {
+		return nil, err
+	}
+	return &cityDetail{detail}, nil
+}
+
+func (c *city) PrayerTimes(args struct {
+	From     *string
+	To       *string
+	TimeZone *string
+}) ([]*prayerTime, error) {
+	tz, err := timezone(args.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	var fromDate, toDate string
+	if args.From != nil {
+		fromDate = *args.From
+	}
+	if args.To != nil {
+		toDate = *args.To
+	}
+	from, to, err := c.city.ParsePrayerTimeRange(fromDate, toDate, tz)
+	if err != nil {
+		return nil, err
+	}
+
+	times, err := c.city.GetPrayerTimeRange(from, to, tz)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*prayerTime, len(times))
+	for i, pt := range times {
+		resolvers[i] = &prayerTime{pt}
+	}
+	return resolvers, nil
+}
+
+func (c *city) NextPrayer(args struct{ TimeZone *string }) (*nextPrayer, error) {
+	tz, err := timezone(args.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	times, err := c.city.GetPrayerTimeWeekly(tz)
+	if err != nil {
+		return nil, err
+	}
+	next, err := diyanet.NextPrayer(times, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &nextPrayer{next}, nil
+}
+
+// cityDetail resolves the CityDetail type.
+type cityDetail struct {
+	detail *diyanet.CityDetail
+}
+
+func (d *cityDetail) Name() string {
+	return d.detail.LocalizedName(diyanet.Turkish)
+}
+
+func (d *cityDetail) NameEn() string {
+	return d.detail.CityEn
+}
+
+func (d *cityDetail) Country() string {
+	return d.detail.LocalizedCountryName(diyanet.Turkish)
+}
+
+func (d *cityDetail) CountryEn() string {
+	return d.detail.CountryEn
+}
+
+func (d *cityDetail) QiblaAngle() *float64 {
+	return optional(d.detail.QiblaAngleDegrees())
+}
+
+func (d *cityDetail) GeographicQiblaAngle() *float64 {
+	return optional(d.detail.GeographicQiblaAngleDegrees())
+}
+
+func (d *cityDetail) DistanceToKaabaKm() *float64 {
+	return optional(d.detail.DistanceToKaabaKm())
+}
+
+// prayerTime resolves the PrayerTime type.
+type prayerTime struct {
+	pt diyanet.PrayerTime
+}
+
+func (p *prayerTime) Date() string {
+	return p.pt.GregorianDate.Format(time.DateOnly)
+}
+
+func (p *prayerTime) GregorianDateLong() string {
+	return p.pt.GregorianDateLong
+}
+
+func (p *prayerTime) HijriDateShort() string {
+	return p.pt.HijriDateShort
+}
+
+func (p *prayerTime) HijriDateLong() string {
+	return p.pt.HijriDateLong
+}
+
+func (p *prayerTime) Fajr() string {
+	return p.pt.Fajr
+}
+
+func (p *prayerTime) Sunrise() string {
+	return p.pt.Sunrise
+}
+
+func (p *prayerTime) Dhuhr() string {
+	return p.pt.Dhuhr
+}
+
+func (p *prayerTime) Asr() string {
+	return p.pt.Asr
+}
+
+func (p *prayerTime) Maghrib() string {
+	return p.pt.Maghrib
+}
+
+func (p *prayerTime) Isha() string {
+	return p.pt.Isha
+}
+
+func (p *prayerTime) AstronomicalSunrise() string {
+	return p.pt.AstronomicalSunrise
+}
+
+func (p *prayerTime) AstronomicalSunset() string {
+	return p.pt.AstronomicalSunset
+}
+
+func (p *prayerTime) QiblaTime() string {
+	return p.pt.QiblaTime
+}
+
+func (p *prayerTime) ShapeMoonURL() string {
+	return p.pt.ShapeMoonURL
+}
+
+func (p *prayerTime) GreenwichMeanTimeZone() float64 {
+	return float64(p.pt.GreenwichMeanTimeZone)
+}
+
+func (p *prayerTime) TimeZone() string {
+	return p.pt.GregorianDate.Location().String()
+}
+
+func (p *prayerTime) Times() []*prayerInstant {
+	prayers, err := p.pt.Prayers()
+	if err != nil {
+		return []*prayerInstant{}
+	}
+	instants := make([]*prayerInstant, len(prayers))
+	for i, prayer := range prayers {
+		instants[i] = &prayerInstant{prayer}
+	}
+	return instants
+}
+
+// prayerInstant resolves the PrayerInstant type.
+type prayerInstant struct {
+	prayer diyanet.Prayer
+}
+
+func (p *prayerInstant) Prayer() string {
+	return prayerEnum(p.prayer.Name)
+}
+
+func (p *prayerInstant) Time() string {
+	return p.prayer.Time.Format(time.RFC3339)
+}
+
+// nextPrayer resolves the NextPrayer type.
+type nextPrayer struct {
+	next diyanet.UpcomingPrayer
+}
+
+func (n *nextPrayer) Prayer() string {
+	return prayerEnum(n.next.Name)
+}
+
+func (n *nextPrayer) Time() string {
+	return n.next.Time.Format(time.RFC3339)
+}
+
+func (n *nextPrayer) RemainingSeconds() int32 {
+	return int32(n.next.Remaining / time.Second)
+}
+
+// dailyContent resolves the DailyContent type.
+type dailyContent struct {
+	content *diyanet.DailyContent
+}
+
+func (d *dailyContent) DayOfYear() int32 {
+	return int32(d.content.DayOfYear)
+}
+
+func (d *dailyContent) Verse() string {
+	return d.content.Verse
+}
+
+func (d *dailyContent) VerseSource() string {
+	return d.content.VerseSource
+}
+
+func (d *dailyContent) Hadith() string {
+	return d.content.Hadith
+}
+
+func (d *dailyContent) HadithSource() string {
+	return d.content.HadithSource
+}
+
+func (d *dailyContent) Prayer() string {
+	return d.content.Pray
+}
+
+func (d *dailyContent) PrayerSource() string {
+	return d.content.PraySource
+}
+
+// prayerEnum returns the value of the Prayer enum for name.
+func prayerEnum(name diyanet.PrayerName) string {
+	return strings.ToUpper(name.String())
+}
+
+// optional returns a pointer to v, or nil if err is not nil.
+func optional(v float64, err error) *float64 {
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
+// timezone returns the time zone named by name, or nil if name is nil.
+func timezone(name *string) (*time.Location, error) {
+	if name == nil {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(*name)
+	if err != nil {
+		return nil, errors.New(errorPrefix + "unknown time zone " + *name)
+	}
+	return loc, nil
+}
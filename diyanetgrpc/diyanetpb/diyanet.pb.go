@@ -0,0 +1,1026 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: diyanetgrpc/diyanetpb/diyanet.proto
+
+package diyanetpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Prayer is the name of a prayer time.
+type Prayer int32
+
+const (
+	Prayer_PRAYER_UNSPECIFIED Prayer = 0
+	Prayer_PRAYER_FAJR        Prayer = 1
+	Prayer_PRAYER_SUNRISE     Prayer = 2
+	Prayer_PRAYER_DHUHR       Prayer = 3
+	Prayer_PRAYER_ASR         Prayer = 4
+	Prayer_PRAYER_MAGHRIB     Prayer = 5
+	Prayer_PRAYER_ISHA        Prayer = 6
+)
+
+// Enum value maps for Prayer.
+var (
+	Prayer_name = map[int32]string{
+		0: "PRAYER_UNSPECIFIED",
+		1: "PRAYER_FAJR",
+		2: "PRAYER_SUNRISE",
+		3: "PRAYER_DHUHR",
+		4: "PRAYER_ASR",
+		5: "PRAYER_MAGHRIB",
+		6: "PRAYER_ISHA",
+	}
+	Prayer_value = map[string]int32{
+		"PRAYER_UNSPECIFIED": 0,
+		"PRAYER_FAJR":        1,
+		"PRAYER_SUNRISE":     2,
+		"PRAYER_DHUHR":       3,
+		"PRAYER_ASR":         4,
+		"PRAYER_MAGHRIB":     5,
+		"PRAYER_ISHA":        6,
+	}
+)
+
+func (x Prayer) Enum() *Prayer {
+	p := new(Prayer)
+	*p = x
+	return p
+}
+
+func (x Prayer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Prayer) Descriptor() protoreflect.EnumDescriptor {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_enumTypes[0].Descriptor()
+}
+
+func (Prayer) Type() protoreflect.EnumType {
+	return &file_diyanetgrpc_diyanetpb_diyanet_proto_enumTypes[0]
+}
+
+func (x Prayer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Prayer.Descriptor instead.
+func (Prayer) EnumDescriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{0}
+}
+
+type GetPrayerTimesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CityId int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	// start_date is the first day as YYYY-MM-DD. Defaults to today.
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date is the last day as YYYY-MM-DD. Defaults to start_date.
+	EndDate string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// time_zone is an IANA time zone selecting the dates and the zone of the times, e.g. "Europe/Istanbul".
+	// Defaults to the zone of the city.
+	TimeZone      string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrayerTimesRequest) Reset() {
+	*x = GetPrayerTimesRequest{}
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrayerTimesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrayerTimesRequest) ProtoMessage() {}
+
+func (x *GetPrayerTimesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrayerTimesRequest.ProtoReflect.Descriptor instead.
+func (*GetPrayerTimesRequest) Descriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPrayerTimesRequest) GetCityId() int32 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *GetPrayerTimesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetPrayerTimesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetPrayerTimesRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type GetPrayerTimesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrayerTimes   []*PrayerTime          `protobuf:"bytes,1,rep,name=prayer_times,json=prayerTimes,proto3" json:"prayer_times,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrayerTimesResponse) Reset() {
+	*x = GetPrayerTimesResponse{}
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrayerTimesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrayerTimesResponse) ProtoMessage() {}
+
+func (x *GetPrayerTimesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrayerTimesResponse.ProtoReflect.Descriptor instead.
+func (*GetPrayerTimesResponse) Descriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPrayerTimesResponse) GetPrayerTimes() []*PrayerTime {
+	if x != nil {
+		return x.PrayerTimes
+	}
+	return nil
+}
+
+// PrayerTime is the prayer times of a day as published, with the instants of the prayers.
+type PrayerTime struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CityId int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	// date is the Gregorian date as YYYY-MM-DD.
+	Date              string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	GregorianDateLong string `protobuf:"bytes,3,opt,name=gregorian_date_long,json=gregorianDateLong,proto3" json:"gregorian_date_long,omitempty"`
+	HijriDateShort    string `protobuf:"bytes,4,opt,name=hijri_date_short,json=hijriDateShort,proto3" json:"hijri_date_short,omitempty"`
+	HijriDateLong     string `protobuf:"bytes,5,opt,name=hijri_date_long,json=hijriDateLong,proto3" json:"hijri_date_long,omitempty"`
+	// fajr to isha are the clock times as published, e.g. "05:42".
+	Fajr                string `protobuf:"bytes,6,opt,name=fajr,proto3" json:"fajr,omitempty"`
+	Sunrise             string `protobuf:"bytes,7,opt,name=sunrise,proto3" json:"sunrise,omitempty"`
+	Dhuhr               string `protobuf:"bytes,8,opt,name=dhuhr,proto3" json:"dhuhr,omitempty"`
+	Asr                 string `protobuf:"bytes,9,opt,name=asr,proto3" json:"asr,omitempty"`
+	Maghrib             string `protobuf:"bytes,10,opt,name=maghrib,proto3" json:"maghrib,omitempty"`
+	Isha                string `protobuf:"bytes,11,opt,name=isha,proto3" json:"isha,omitempty"`
+	AstronomicalSunrise string `protobuf:"bytes,12,opt,name=astronomical_sunrise,json=astronomicalSunrise,proto3" json:"astronomical_sunrise,omitempty"`
+	AstronomicalSunset  string `protobuf:"bytes,13,opt,name=astronomical_sunset,json=astronomicalSunset,proto3" json:"astronomical_sunset,omitempty"`
+	QiblaTime           string `protobuf:"bytes,14,opt,name=qibla_time,json=qiblaTime,proto3" json:"qibla_time,omitempty"`
+	ShapeMoonUrl        string `protobuf:"bytes,15,opt,name=shape_moon_url,json=shapeMoonUrl,proto3" json:"shape_moon_url,omitempty"`
+	// greenwich_mean_time_zone is the offset from GMT in hours as published.
+	GreenwichMeanTimeZone float32 `protobuf:"fixed32,16,opt,name=greenwich_mean_time_zone,json=greenwichMeanTimeZone,proto3" json:"greenwich_mean_time_zone,omitempty"`
+	// time_zone is the name of the zone of the instants.
+	TimeZone string `protobuf:"bytes,17,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// times are the instants of the prayers in chronological order.
+	Times         []*PrayerInstant `protobuf:"bytes,18,rep,name=times,proto3" json:"times,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrayerTime) Reset() {
+	*x = PrayerTime{}
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrayerTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrayerTime) ProtoMessage() {}
+
+func (x *PrayerTime) ProtoReflect() protoreflect.Message {
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrayerTime.ProtoReflect.Descriptor instead.
+func (*PrayerTime) Descriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PrayerTime) GetCityId() int32 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *PrayerTime) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetGregorianDateLong() string {
+	if x != nil {
+		return x.GregorianDateLong
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetHijriDateShort() string {
+	if x != nil {
+		return x.HijriDateShort
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetHijriDateLong() string {
+	if x != nil {
+		return x.HijriDateLong
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetFajr() string {
+	if x != nil {
+		return x.Fajr
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetSunrise() string {
+	if x != nil {
+		return x.Sunrise
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetDhuhr() string {
+	if x != nil {
+		return x.Dhuhr
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetAsr() string {
+	if x != nil {
+		return x.Asr
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetMaghrib() string {
+	if x != nil {
+		return x.Maghrib
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetIsha() string {
+	if x != nil {
+		return x.Isha
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetAstronomicalSunrise() string {
+	if x != nil {
+		return x.AstronomicalSunrise
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetAstronomicalSunset() string {
+	if x != nil {
+		return x.AstronomicalSunset
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetQiblaTime() string {
+	if x != nil {
+		return x.QiblaTime
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetShapeMoonUrl() string {
+	if x != nil {
+		return x.ShapeMoonUrl
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetGreenwichMeanTimeZone() float32 {
+	if x != nil {
+		return x.GreenwichMeanTimeZone
+	}
+	return 0
+}
+
+func (x *PrayerTime) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *PrayerTime) GetTimes() []*PrayerInstant {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+type PrayerInstant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prayer        Prayer                 `protobuf:"varint,1,opt,name=prayer,proto3,enum=diyanet.v1.Prayer" json:"prayer,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrayerInstant) Reset() {
+	*x = PrayerInstant{}
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrayerInstant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrayerInstant) ProtoMessage() {}
+
+func (x *PrayerInstant) ProtoReflect() protoreflect.Message {
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrayerInstant.ProtoReflect.Descriptor instead.
+func (*PrayerInstant) Descriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PrayerInstant) GetPrayer() Prayer {
+	if x != nil {
+		return x.Prayer
+	}
+	return Prayer_PRAYER_UNSPECIFIED
+}
+
+func (x *PrayerInstant) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type GetNextPrayerRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CityId int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	// time_zone is an IANA time zone, see GetPrayerTimesRequest.
+	TimeZone      string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextPrayerRequest) Reset() {
+	*x = GetNextPrayerRequest{}
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextPrayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextPrayerRequest) ProtoMessage() {}
+
+func (x *GetNextPrayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextPrayerRequest.ProtoReflect.Descriptor instead.
+func (*GetNextPrayerRequest) Descriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetNextPrayerRequest) GetCityId() int32 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *GetNextPrayerRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type NextPrayer struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CityId int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Prayer Prayer                 `protobuf:"varint,2,opt,name=prayer,proto3,enum=diyanet.v1.Prayer" json:"prayer,omitempty"`
+	Time   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// remaining_seconds is the time from sending until the prayer begins.
+	RemainingSeconds int64 `protobuf:"varint,4,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NextPrayer) Reset() {
+	*x = NextPrayer{}
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextPrayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextPrayer) ProtoMessage() {}
+
+func (x *NextPrayer) ProtoReflect() protoreflect.Message {
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextPrayer.ProtoReflect.Descriptor instead.
+func (*NextPrayer) Descriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NextPrayer) GetCityId() int32 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *NextPrayer) GetPrayer() Prayer {
+	if x != nil {
+		return x.Prayer
+	}
+	return Prayer_PRAYER_UNSPECIFIED
+}
+
+func (x *NextPrayer) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *NextPrayer) GetRemainingSeconds() int64 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+type SearchPlacesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query is the city name, which may be misspelled or abbreviated.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// country restricts the search to the country with the given code or name, if not empty.
+	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	// limit is the maximum number of matches returned. Defaults to 10.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPlacesRequest) Reset() {
+	*x = SearchPlacesRequest{}
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPlacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPlacesRequest) ProtoMessage() {}
+
+func (x *SearchPlacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPlacesRequest.ProtoReflect.Descriptor instead.
+func (*SearchPlacesRequest) Descriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchPlacesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPlacesRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *SearchPlacesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchPlacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*PlaceMatch          `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPlacesResponse) Reset() {
+	*x = SearchPlacesResponse{}
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPlacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPlacesResponse) ProtoMessage() {}
+
+func (x *SearchPlacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPlacesResponse.ProtoReflect.Descriptor instead.
+func (*SearchPlacesResponse) Descriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchPlacesResponse) GetMatches() []*PlaceMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type PlaceMatch struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CityId int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Code   string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// score rates the match from 0 (unrelated) to 1 (exact match).
+	Score         float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceMatch) Reset() {
+	*x = PlaceMatch{}
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceMatch) ProtoMessage() {}
+
+func (x *PlaceMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceMatch.ProtoReflect.Descriptor instead.
+func (*PlaceMatch) Descriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlaceMatch) GetCityId() int32 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *PlaceMatch) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PlaceMatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlaceMatch) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetDailyContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyContentRequest) Reset() {
+	*x = GetDailyContentRequest{}
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyContentRequest) ProtoMessage() {}
+
+func (x *GetDailyContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyContentRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyContentRequest) Descriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{9}
+}
+
+type DailyContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DayOfYear     int32                  `protobuf:"varint,1,opt,name=day_of_year,json=dayOfYear,proto3" json:"day_of_year,omitempty"`
+	Verse         string                 `protobuf:"bytes,2,opt,name=verse,proto3" json:"verse,omitempty"`
+	VerseSource   string                 `protobuf:"bytes,3,opt,name=verse_source,json=verseSource,proto3" json:"verse_source,omitempty"`
+	Hadith        string                 `protobuf:"bytes,4,opt,name=hadith,proto3" json:"hadith,omitempty"`
+	HadithSource  string                 `protobuf:"bytes,5,opt,name=hadith_source,json=hadithSource,proto3" json:"hadith_source,omitempty"`
+	Prayer        string                 `protobuf:"bytes,6,opt,name=prayer,proto3" json:"prayer,omitempty"`
+	PrayerSource  string                 `protobuf:"bytes,7,opt,name=prayer_source,json=prayerSource,proto3" json:"prayer_source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyContent) Reset() {
+	*x = DailyContent{}
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyContent) ProtoMessage() {}
+
+func (x *DailyContent) ProtoReflect() protoreflect.Message {
+	mi := &file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyContent.ProtoReflect.Descriptor instead.
+func (*DailyContent) Descriptor() ([]byte, []int) {
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DailyContent) GetDayOfYear() int32 {
+	if x != nil {
+		return x.DayOfYear
+	}
+	return 0
+}
+
+func (x *DailyContent) GetVerse() string {
+	if x != nil {
+		return x.Verse
+	}
+	return ""
+}
+
+func (x *DailyContent) GetVerseSource() string {
+	if x != nil {
+		return x.VerseSource
+	}
+	return ""
+}
+
+func (x *DailyContent) GetHadith() string {
+	if x != nil {
+		return x.Hadith
+	}
+	return ""
+}
+
+func (x *DailyContent) GetHadithSource() string {
+	if x != nil {
+		return x.HadithSource
+	}
+	return ""
+}
+
+func (x *DailyContent) GetPrayer() string {
+	if x != nil {
+		return x.Prayer
+	}
+	return ""
+}
+
+func (x *DailyContent) GetPrayerSource() string {
+	if x != nil {
+		return x.PrayerSource
+	}
+	return ""
+}
+
+var File_diyanetgrpc_diyanetpb_diyanet_proto protoreflect.FileDescriptor
+
+const file_diyanetgrpc_diyanetpb_diyanet_proto_rawDesc = "" +
+	"\n" +
+	"#diyanetgrpc/diyanetpb/diyanet.proto\x12\n" +
+	"diyanet.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x87\x01\n" +
+	"\x15GetPrayerTimesRequest\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\x05R\x06cityId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\"S\n" +
+	"\x16GetPrayerTimesResponse\x129\n" +
+	"\fprayer_times\x18\x01 \x03(\v2\x16.diyanet.v1.PrayerTimeR\vprayerTimes\"\xef\x04\n" +
+	"\n" +
+	"PrayerTime\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\x05R\x06cityId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12.\n" +
+	"\x13gregorian_date_long\x18\x03 \x01(\tR\x11gregorianDateLong\x12(\n" +
+	"\x10hijri_date_short\x18\x04 \x01(\tR\x0ehijriDateShort\x12&\n" +
+	"\x0fhijri_date_long\x18\x05 \x01(\tR\rhijriDateLong\x12\x12\n" +
+	"\x04fajr\x18\x06 \x01(\tR\x04fajr\x12\x18\n" +
+	"\asunrise\x18\a \x01(\tR\asunrise\x12\x14\n" +
+	"\x05dhuhr\x18\b \x01(\tR\x05dhuhr\x12\x10\n" +
+	"\x03asr\x18\t \x01(\tR\x03asr\x12\x18\n" +
+	"\amaghrib\x18\n" +
+	" \x01(\tR\amaghrib\x12\x12\n" +
+	"\x04isha\x18\v \x01(\tR\x04isha\x121\n" +
+	"\x14astronomical_sunrise\x18\f \x01(\tR\x13astronomicalSunrise\x12/\n" +
+	"\x13astronomical_sunset\x18\r \x01(\tR\x12astronomicalSunset\x12\x1d\n" +
+	"\n" +
+	"qibla_time\x18\x0e \x01(\tR\tqiblaTime\x12$\n" +
+	"\x0eshape_moon_url\x18\x0f \x01(\tR\fshapeMoonUrl\x127\n" +
+	"\x18greenwich_mean_time_zone\x18\x10 \x01(\x02R\x15greenwichMeanTimeZone\x12\x1b\n" +
+	"\ttime_zone\x18\x11 \x01(\tR\btimeZone\x12/\n" +
+	"\x05times\x18\x12 \x03(\v2\x19.diyanet.v1.PrayerInstantR\x05times\"k\n" +
+	"\rPrayerInstant\x12*\n" +
+	"\x06prayer\x18\x01 \x01(\x0e2\x12.diyanet.v1.PrayerR\x06prayer\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"L\n" +
+	"\x14GetNextPrayerRequest\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\x05R\x06cityId\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\"\xae\x01\n" +
+	"\n" +
+	"NextPrayer\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\x05R\x06cityId\x12*\n" +
+	"\x06prayer\x18\x02 \x01(\x0e2\x12.diyanet.v1.PrayerR\x06prayer\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12+\n" +
+	"\x11remaining_seconds\x18\x04 \x01(\x03R\x10remainingSeconds\"[\n" +
+	"\x13SearchPlacesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"H\n" +
+	"\x14SearchPlacesResponse\x120\n" +
+	"\amatches\x18\x01 \x03(\v2\x16.diyanet.v1.PlaceMatchR\amatches\"c\n" +
+	"\n" +
+	"PlaceMatch\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\x05R\x06cityId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\"\x18\n" +
+	"\x16GetDailyContentRequest\"\xe1\x01\n" +
+	"\fDailyContent\x12\x1e\n" +
+	"\vday_of_year\x18\x01 \x01(\x05R\tdayOfYear\x12\x14\n" +
+	"\x05verse\x18\x02 \x01(\tR\x05verse\x12!\n" +
+	"\fverse_source\x18\x03 \x01(\tR\vverseSource\x12\x16\n" +
+	"\x06hadith\x18\x04 \x01(\tR\x06hadith\x12#\n" +
+	"\rhadith_source\x18\x05 \x01(\tR\fhadithSource\x12\x16\n" +
+	"\x06prayer\x18\x06 \x01(\tR\x06prayer\x12#\n" +
+	"\rprayer_source\x18\a \x01(\tR\fprayerSource*\x8c\x01\n" +
+	"\x06Prayer\x12\x16\n" +
+	"\x12PRAYER_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vPRAYER_FAJR\x10\x01\x12\x12\n" +
+	"\x0ePRAYER_SUNRISE\x10\x02\x12\x10\n" +
+	"\fPRAYER_DHUHR\x10\x03\x12\x0e\n" +
+	"\n" +
+	"PRAYER_ASR\x10\x04\x12\x12\n" +
+	"\x0ePRAYER_MAGHRIB\x10\x05\x12\x0f\n" +
+	"\vPRAYER_ISHA\x10\x062\xdd\x02\n" +
+	"\x11PrayerTimeService\x12W\n" +
+	"\x0eGetPrayerTimes\x12!.diyanet.v1.GetPrayerTimesRequest\x1a\".diyanet.v1.GetPrayerTimesResponse\x12K\n" +
+	"\rGetNextPrayer\x12 .diyanet.v1.GetNextPrayerRequest\x1a\x16.diyanet.v1.NextPrayer0\x01\x12Q\n" +
+	"\fSearchPlaces\x12\x1f.diyanet.v1.SearchPlacesRequest\x1a .diyanet.v1.SearchPlacesResponse\x12O\n" +
+	"\x0fGetDailyContent\x12\".diyanet.v1.GetDailyContentRequest\x1a\x18.diyanet.v1.DailyContentBCZAgithub.com/abduelhamit/DiyanetAwqatSalahAPI/diyanetgrpc/diyanetpbb\x06proto3"
+
+var (
+	file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescOnce sync.Once
+	file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescData []byte
+)
+
+func file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescGZIP() []byte {
+	file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescOnce.Do(func() {
+		file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_diyanetgrpc_diyanetpb_diyanet_proto_rawDesc), len(file_diyanetgrpc_diyanetpb_diyanet_proto_rawDesc)))
+	})
+	return file_diyanetgrpc_diyanetpb_diyanet_proto_rawDescData
+}
+
+var file_diyanetgrpc_diyanetpb_diyanet_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_diyanetgrpc_diyanetpb_diyanet_proto_goTypes = []any{
+	(Prayer)(0),                    // 0: diyanet.v1.Prayer
+	(*GetPrayerTimesRequest)(nil),  // 1: diyanet.v1.GetPrayerTimesRequest
+	(*GetPrayerTimesResponse)(nil), // 2: diyanet.v1.GetPrayerTimesResponse
+	(*PrayerTime)(nil),             // 3: diyanet.v1.PrayerTime
+	(*PrayerInstant)(nil),          // 4: diyanet.v1.PrayerInstant
+	(*GetNextPrayerRequest)(nil),   // 5: diyanet.v1.GetNextPrayerRequest
+	(*NextPrayer)(nil),             // 6: diyanet.v1.NextPrayer
+	(*SearchPlacesRequest)(nil),    // 7: diyanet.v1.SearchPlacesRequest
+	(*SearchPlacesResponse)(nil),   // 8: diyanet.v1.SearchPlacesResponse
+	(*PlaceMatch)(nil),             // 9: diyanet.v1.PlaceMatch
+	(*GetDailyContentRequest)(nil), // 10: diyanet.v1.GetDailyContentRequest
+	(*DailyContent)(nil),           // 11: diyanet.v1.DailyContent
+	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
+}
+var file_diyanetgrpc_diyanetpb_diyanet_proto_depIdxs = []int32{
+	3,  // 0: diyanet.v1.GetPrayerTimesResponse.prayer_times:type_name -> diyanet.v1.PrayerTime
+	4,  // 1: diyanet.v1.PrayerTime.times:type_name -> diyanet.v1.PrayerInstant
+	0,  // 2: diyanet.v1.PrayerInstant.prayer:type_name -> diyanet.v1.Prayer
+	12, // 3: diyanet.v1.PrayerInstant.time:type_name -> google.protobuf.Timestamp
+	0,  // 4: diyanet.v1.NextPrayer.prayer:type_name -> diyanet.v1.Prayer
+	12, // 5: diyanet.v1.NextPrayer.time:type_name -> google.protobuf.Timestamp
+	9,  // 6: diyanet.v1.SearchPlacesResponse.matches:type_name -> diyanet.v1.PlaceMatch
+	1,  // 7: diyanet.v1.PrayerTimeService.GetPrayerTimes:input_type -> diyanet.v1.GetPrayerTimesRequest
+	5,  // 8: diyanet.v1.PrayerTimeService.GetNextPrayer:input_type -> diyanet.v1.GetNextPrayerRequest
+	7,  // 9: diyanet.v1.PrayerTimeService.SearchPlaces:input_type -> diyanet.v1.SearchPlacesRequest
+	10, // 10: diyanet.v1.PrayerTimeService.GetDailyContent:input_type -> diyanet.v1.GetDailyContentRequest
+	2,  // 11: diyanet.v1.PrayerTimeService.GetPrayerTimes:output_type -> diyanet.v1.GetPrayerTimesResponse
+	6,  // 12: diyanet.v1.PrayerTimeService.GetNextPrayer:output_type -> diyanet.v1.NextPrayer
+	8,  // 13: diyanet.v1.PrayerTimeService.SearchPlaces:output_type -> diyanet.v1.SearchPlacesResponse
+	11, // 14: diyanet.v1.PrayerTimeService.GetDailyContent:output_type -> diyanet.v1.DailyContent
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_diyanetgrpc_diyanetpb_diyanet_proto_init() }
+func file_diyanetgrpc_diyanetpb_diyanet_proto_init() {
+	if File_diyanetgrpc_diyanetpb_diyanet_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_diyanetgrpc_diyanetpb_diyanet_proto_rawDesc), len(file_diyanetgrpc_diyanetpb_diyanet_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_diyanetgrpc_diyanetpb_diyanet_proto_goTypes,
+		DependencyIndexes: file_diyanetgrpc_diyanetpb_diyanet_proto_depIdxs,
+		EnumInfos:         file_diyanetgrpc_diyanetpb_diyanet_proto_enumTypes,
+		MessageInfos:      file_diyanetgrpc_diyanetpb_diyanet_proto_msgTypes,
+	}.Build()
+	File_diyanetgrpc_diyanetpb_diyanet_proto = out.File
+	file_diyanetgrpc_diyanetpb_diyanet_proto_goTypes = nil
+	file_diyanetgrpc_diyanetpb_diyanet_proto_depIdxs = nil
+}
@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: diyanetgrpc/diyanetpb/diyanet.proto
+
+package diyanetpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PrayerTimeService_GetPrayerTimes_FullMethodName  = "/diyanet.v1.PrayerTimeService/GetPrayerTimes"
+	PrayerTimeService_GetNextPrayer_FullMethodName   = "/diyanet.v1.PrayerTimeService/GetNextPrayer"
+	PrayerTimeService_SearchPlaces_FullMethodName    = "/diyanet.v1.PrayerTimeService/SearchPlaces"
+	PrayerTimeService_GetDailyContent_FullMethodName = "/diyanet.v1.PrayerTimeService/GetDailyContent"
+)
+
+// PrayerTimeServiceClient is the client API for PrayerTimeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PrayerTimeService serves the prayer times, places and daily content of the Diyanet Awqat Salah API.
+type PrayerTimeServiceClient interface {
+	// GetPrayerTimes returns the prayer times of a city for the days from start_date to end_date, which lie
+	// within the next 30 days.
+	GetPrayerTimes(ctx context.Context, in *GetPrayerTimesRequest, opts ...grpc.CallOption) (*GetPrayerTimesResponse, error)
+	// GetNextPrayer streams the next prayer of a city: once when called and again whenever a prayer begins,
+	// until the call is cancelled.
+	GetNextPrayer(ctx context.Context, in *GetNextPrayerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NextPrayer], error)
+	// SearchPlaces searches cities by name using fuzzy matching, best match first.
+	SearchPlaces(ctx context.Context, in *SearchPlacesRequest, opts ...grpc.CallOption) (*SearchPlacesResponse, error)
+	// GetDailyContent returns the verse, hadith and prayer of the day.
+	GetDailyContent(ctx context.Context, in *GetDailyContentRequest, opts ...grpc.CallOption) (*DailyContent, error)
+}
+
+type prayerTimeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPrayerTimeServiceClient(cc grpc.ClientConnInterface) PrayerTimeServiceClient {
+	return &prayerTimeServiceClient{cc}
+}
+
+func (c *prayerTimeServiceClient) GetPrayerTimes(ctx context.Context, in *GetPrayerTimesRequest, opts ...grpc.CallOption) (*GetPrayerTimesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrayerTimesResponse)
+	err := c.cc.Invoke(ctx, PrayerTimeService_GetPrayerTimes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prayerTimeServiceClient) GetNextPrayer(ctx context.Context, in *GetNextPrayerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NextPrayer], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PrayerTimeService_ServiceDesc.Streams[0], PrayerTimeService_GetNextPrayer_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetNextPrayerRequest, NextPrayer]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PrayerTimeService_GetNextPrayerClient = grpc.ServerStreamingClient[NextPrayer]
+
+func (c *prayerTimeServiceClient) SearchPlaces(ctx context.Context, in *SearchPlacesRequest, opts ...grpc.CallOption) (*SearchPlacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPlacesResponse)
+	err := c.cc.Invoke(ctx, PrayerTimeService_SearchPlaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prayerTimeServiceClient) GetDailyContent(ctx context.Context, in *GetDailyContentRequest, opts ...grpc.CallOption) (*DailyContent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DailyContent)
+	err := c.cc.Invoke(ctx, PrayerTimeService_GetDailyContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PrayerTimeServiceServer is the server API for PrayerTimeService service.
+// All implementations must embed UnimplementedPrayerTimeServiceServer
+// for forward compatibility.
+//
+// PrayerTimeService serves the prayer times, places and daily content of the Diyanet Awqat Salah API.
+type PrayerTimeServiceServer interface {
+	// GetPrayerTimes returns the prayer times of a city for the days from start_date to end_date, which lie
+	// within the next 30 days.
+	GetPrayerTimes(context.Context, *GetPrayerTimesRequest) (*GetPrayerTimesResponse, error)
+	// GetNextPrayer streams the next prayer of a city: once when called and again whenever a prayer begins,
+	// until the call is cancelled.
+	GetNextPrayer(*GetNextPrayerRequest, grpc.ServerStreamingServer[NextPrayer]) error
+	// SearchPlaces searches cities by name using fuzzy matching, best match first.
+	SearchPlaces(context.Context, *SearchPlacesRequest) (*SearchPlacesResponse, error)
+	// GetDailyContent returns the verse, hadith and prayer of the day.
+	GetDailyContent(context.Context, *GetDailyContentRequest) (*DailyContent, error)
+	mustEmbedUnimplementedPrayerTimeServiceServer()
+}
+
+// UnimplementedPrayerTimeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPrayerTimeServiceServer struct{}
+
+func (UnimplementedPrayerTimeServiceServer) GetPrayerTimes(context.Context, *GetPrayerTimesRequest) (*GetPrayerTimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrayerTimes not implemented")
+}
+func (UnimplementedPrayerTimeServiceServer) GetNextPrayer(*GetNextPrayerRequest, grpc.ServerStreamingServer[NextPrayer]) error {
+	return status.Errorf(codes.Unimplemented, "method GetNextPrayer not implemented")
+}
+func (UnimplementedPrayerTimeServiceServer) SearchPlaces(context.Context, *SearchPlacesRequest) (*SearchPlacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPlaces not implemented")
+}
+func (UnimplementedPrayerTimeServiceServer) GetDailyContent(context.Context, *GetDailyContentRequest) (*DailyContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyContent not implemented")
+}
+func (UnimplementedPrayerTimeServiceServer) mustEmbedUnimplementedPrayerTimeServiceServer() {}
+func (UnimplementedPrayerTimeServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafePrayerTimeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PrayerTimeServiceServer will
+// result in compilation errors.
+type UnsafePrayerTimeServiceServer interface {
+	mustEmbedUnimplementedPrayerTimeServiceServer()
+}
+
+func RegisterPrayerTimeServiceServer(s grpc.ServiceRegistrar, srv PrayerTimeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPrayerTimeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PrayerTimeService_ServiceDesc, srv)
+}
+
+func _PrayerTimeService_GetPrayerTimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrayerTimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrayerTimeServiceServer).GetPrayerTimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrayerTimeService_GetPrayerTimes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrayerTimeServiceServer).GetPrayerTimes(ctx, req.(*GetPrayerTimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrayerTimeService_GetNextPrayer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetNextPrayerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PrayerTimeServiceServer).GetNextPrayer(m, &grpc.GenericServerStream[GetNextPrayerRequest, NextPrayer]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PrayerTimeService_GetNextPrayerServer = grpc.ServerStreamingServer[NextPrayer]
+
+func _PrayerTimeService_SearchPlaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPlacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrayerTimeServiceServer).SearchPlaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrayerTimeService_SearchPlaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrayerTimeServiceServer).SearchPlaces(ctx, req.(*SearchPlacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrayerTimeService_GetDailyContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrayerTimeServiceServer).GetDailyContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrayerTimeService_GetDailyContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrayerTimeServiceServer).GetDailyContent(ctx, req.(*GetDailyContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PrayerTimeService_ServiceDesc is the grpc.ServiceDesc for PrayerTimeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PrayerTimeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "diyanet.v1.PrayerTimeService",
+	HandlerType: (*PrayerTimeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPrayerTimes",
+			Handler:    _PrayerTimeService_GetPrayerTimes_Handler,
+		},
+		{
+			MethodName: "SearchPlaces",
+			Handler:    _PrayerTimeService_SearchPlaces_Handler,
+		},
+		{
+			MethodName: "GetDailyContent",
+			Handler:    _PrayerTimeService_GetDailyContent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetNextPrayer",
+			Handler:       _PrayerTimeService_GetNextPrayer_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "diyanetgrpc/diyanetpb/diyanet.proto",
+}
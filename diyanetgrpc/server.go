@@ -0,0 +1,217 @@
+// Package diyanetgrpc provides a gRPC service over the Diyanet Awqat Salah API, for environments that
+// standardize on gRPC. The service is defined in diyanetpb/diyanet.proto, from which clients in other languages
+// can be generated:
+//
+//	s := grpc.NewServer()
+//	diyanetpb.RegisterPrayerTimeServiceServer(s, diyanetgrpc.New(client))
+//	s.Serve(listener)
+//
+// Failed calls return a status with code InvalidArgument for invalid requests, Unavailable if the client is
+// offline and Internal if the Diyanet Awqat Salah API failed.
+package diyanetgrpc
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative diyanetgrpc/diyanetpb/diyanet.proto
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	diyanet "github.com/abduelhamit/DiyanetAwqatSalahAPI"
+	"github.com/abduelhamit/DiyanetAwqatSalahAPI/diyanetgrpc/diyanetpb"
+)
+
+// Server implements [diyanetpb.PrayerTimeServiceServer]. Create it with [New].
+type Server struct {
+	diyanetpb.UnimplementedPrayerTimeServiceServer
+
+	client diyanet.Client
+}
+
+// New returns a server answering calls with the client, whose cache configuration (see [diyanet.Config])
+// determines how often the Diyanet Awqat Salah API is asked.
+func New(client diyanet.Client) *Server {
+	return &Server{client: client}
+}
+
+// GetPrayerTimes implements [diyanetpb.PrayerTimeServiceServer].
+func (s *Server) GetPrayerTimes(ctx context.Context, req *diyanetpb.GetPrayerTimesRequest) (*diyanetpb.GetPrayerTimesResponse, error) {
+	if req.GetCityId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing or invalid city_id")
+	}
+	tz, err := timezone(req.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+
+	city := s.client.WithContext(ctx).City(int(req.GetCityId()))
+	from, to, err := city.ParsePrayerTimeRange(req.GetStartDate(), req.GetEndDate(), tz)
+	if errors.Is(err, diyanet.ErrInvalidPrayerTimeRange) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, upstreamError(err)
+	}
+
+	times, err := city.GetPrayerTimeRange(from, to, tz)
+	if err != nil {
+		return nil, upstreamError(err)
+	}
+	resp := &diyanetpb.GetPrayerTimesResponse{PrayerTimes: make([]*diyanetpb.PrayerTime, 0, len(times))}
+	for _, pt := range times {
+		resp.PrayerTimes = append(resp.PrayerTimes, prayerTime(pt))
+	}
+	return resp, nil
+}
+
+// GetNextPrayer implements [diyanetpb.PrayerTimeServiceServer]. The weekly prayer times of the city are
+// requested again when they hold no upcoming prayer.
+func (s *Server) GetNextPrayer(req *diyanetpb.GetNextPrayerRequest, stream grpc.ServerStreamingServer[diyanetpb.NextPrayer]) error {
+	if req.GetCityId() <= 0 {
+		return status.Error(codes.InvalidArgument, "missing or invalid city_id")
+	}
+	tz, err := timezone(req.GetTimeZone())
+	if err != nil {
+		return err
+	}
+
+	city := s.client.WithContext(stream.Context()).City(int(req.GetCityId()))
+	var times []diyanet.PrayerTime
+	for {
+		now := time.Now()
+		next, err := diyanet.NextPrayer(times, now)
+		if errors.Is(err, diyanet.ErrNoUpcomingPrayer) {
+			if times, err = city.GetPrayerTimeWeekly(tz); err != nil {
+				return upstreamError(err)
+			}
+			next, err = diyanet.NextPrayer(times, now)
+		}
+		if err != nil {
+			return upstreamError(err)
+		}
+
+		if err := stream.Send(&diyanetpb.NextPrayer{
+			CityId:           req.GetCityId(),
+			Prayer:           prayer(next.Name),
+			Time:             timestamppb.New(next.Time),
+			RemainingSeconds: int64(next.Remaining / time.Second),
+		}); err != nil {
+			return err
+		}
+
+		timer := time.NewTimer(next.Remaining)
+		select {
+		case <-timer.C:
+		case <-stream.Context().Done():
+			timer.Stop()
+			return stream.Context().Err()
+		}
+	}
+}
+
+// SearchPlaces implements [diyanetpb.PrayerTimeServiceServer].
+func (s *Server) SearchPlaces(ctx context.Context, req *diyanetpb.SearchPlacesRequest) (*diyanetpb.SearchPlacesResponse, error) {
+	if req.GetQuery() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing query")
+	}
+
+	matches, err := s.client.WithContext(ctx).FindCity(req.GetQuery(), diyanet.FindCityOptions{Country: req.GetCountry(), Limit: int(req.GetLimit())})
+	if err != nil {
+		return nil, upstreamError(err)
+	}
+	resp := &diyanetpb.SearchPlacesResponse{Matches: make([]*diyanetpb.PlaceMatch, 0, len(matches))}
+	for _, match := range matches {
+		resp.Matches = append(resp.Matches, &diyanetpb.PlaceMatch{
+			CityId: int32(match.City.Id),
+			Code:   match.City.Code,
+			Name:   match.City.Name,
+			Score:  match.Score,
+		})
+	}
+	return resp, nil
+}
+
+// GetDailyContent implements [diyanetpb.PrayerTimeServiceServer].
+func (s *Server) GetDailyContent(ctx context.Context, req *diyanetpb.GetDailyContentRequest) (*diyanetpb.DailyContent, error) {
+	content, err := s.client.WithContext(ctx).GetDailyContent()
+	if err != nil {
+		return nil, upstreamError(err)
+	}
+	return &diyanetpb.DailyContent{
+		DayOfYear:    int32(content.DayOfYear),
+		Verse:        content.Verse,
+		VerseSource:  content.VerseSource,
+		Hadith:       content.Hadith,
+		HadithSource: content.HadithSource,
+		Prayer:       content.Pray,
+		PrayerSource: content.PraySource,
+	}, nil
+}
+
+// prayerTime converts pt to its message. Times is left empty if the clock times are invalid.
+func prayerTime(pt diyanet.PrayerTime) *diyanetpb.PrayerTime {
+	msg := &diyanetpb.PrayerTime{
+		CityId:                int32(pt.CityId),
+		Date:                  pt.GregorianDate.Format(time.DateOnly),
+		GregorianDateLong:     pt.GregorianDateLong,
+		HijriDateShort:        pt.HijriDateShort,
+		HijriDateLong:         pt.HijriDateLong,
+		Fajr:                  pt.Fajr,
+		Sunrise:               pt.Sunrise,
+		Dhuhr:                 pt.Dhuhr,
+		Asr:                   pt.Asr,
+		Maghrib:               pt.Maghrib,
+		Isha:                  pt.Isha,
+		AstronomicalSunrise:   pt.AstronomicalSunrise,
+		AstronomicalSunset:    pt.AstronomicalSunset,
+		QiblaTime:             pt.QiblaTime,
+		ShapeMoonUrl:          pt.ShapeMoonURL,
+		GreenwichMeanTimeZone: pt.GreenwichMeanTimeZone,
+		TimeZone:              pt.GregorianDate.Location().String(),
+	}
+	if prayers, err := pt.Prayers(); err == nil {
+		for _, p := range prayers {
+			msg.Times = append(msg.Times, &diyanetpb.PrayerInstant{Prayer: prayer(p.Name), Time: timestamppb.New(p.Time)})
+		}
+	}
+	return msg
+}
+
+// prayer returns the enum value of name.
+func prayer(name diyanet.PrayerName) diyanetpb.Prayer {
+	return diyanetpb.Prayer(name + 1)
+}
+
+// timezone returns the time zone of name, or nil if empty.
+func timezone(name string) (*time.Location, error) {
+	if name == "" {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "unknown time zone "+name)
+	}
+	return loc, nil
+}
+
+// upstreamError logs err and returns it as status: DeadlineExceeded or Canceled if the call's context ended,
+// Unavailable if the client is offline, Internal otherwise.
+func upstreamError(err error) error {
+	log.Printf("%s; returning error", err)
+	code := codes.Internal
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, diyanet.ErrOffline):
+		code = codes.Unavailable
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,234 @@
+// Package diyanetmetrics provides a Prometheus exporter of prayer-time gauges, so that dashboards and alerts
+// such as "adhan in 10 minutes" need no code:
+//
+//	http.Handle("/metrics", &diyanetmetrics.Exporter{Client: client, Cities: []int{9541}})
+//
+// The exporter serves these metrics in the Prometheus text format, labeled with the city ID:
+//
+//	diyanet_seconds_until_next_prayer{city,prayer}               seconds until the next occurrence of the prayer
+//	diyanet_fasting_seconds_remaining{city}                      seconds until Maghrib between Fajr and Maghrib, 0 otherwise
+//	diyanet_prayer_times_last_refresh_timestamp_seconds{city}    time of the last successful refresh
+//	diyanet_prayer_times_coverage_seconds{city}                  seconds until the last prayer held
+//	diyanet_refresh_errors_total{city}                           failed refreshes
+//
+// An alert for the adhan in 10 minutes is then diyanet_seconds_until_next_prayer{prayer!="Sunrise"} < 600.
+package diyanetmetrics
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	diyanet "github.com/abduelhamit/DiyanetAwqatSalahAPI"
+)
+
+const errorPrefix = "diyanet: "
+
+// DefaultRefreshInterval is how often an [Exporter] requests the prayer times again when no interval is given.
+const DefaultRefreshInterval = 6 * time.Hour
+
+const (
+	// refreshTimeout bounds the request of the prayer times of a city.
+	refreshTimeout = time.Minute
+	// retryInterval is how long the exporter waits after a failed refresh of a city before trying again;
+	// it doubles with every further failure, up to the refresh interval.
+	retryInterval = time.Minute
+)
+
+// Exporter is an [http.Handler] serving the prayer-time gauges of the cities. The weekly prayer times of a city
+// are requested in the background on the first scrape, after the refresh interval and when they hold no
+// upcoming prayer, so scrapes are served immediately with the prayer times held; a city's gauges are missing
+// until its first refresh succeeded. Failed refreshes keep the previous prayer times and are retried with
+// backoff. The zero value is not usable; set at least Client and Cities.
+type Exporter struct {
+	// Client requests the prayer times.
+	Client diyanet.Client
+	// Cities are the distinct IDs of the exported cities.
+	Cities []int
+	// Timezone is the time zone of the prayer times, see [diyanet.City.GetPrayerTimeWeekly].
+	Timezone *time.Location
+	// RefreshInterval is how often the prayer times are requested again. Defaults to [DefaultRefreshInterval].
+	RefreshInterval time.Duration
+
+	mu     sync.Mutex
+	cities map[int]*cityState
+}
+
+// cityState is the prayer times of a city held by an [Exporter].
+type cityState struct {
+	prayers   []diyanet.Prayer
+	refreshed time.Time
+	errors    int
+
+	// refreshing is set while a refresh is running; failures counts the consecutive failed refreshes,
+	// after which the next refresh waits until retry.
+	refreshing bool
+	failures   int
+	retry      time.Time
+}
+
+// ServeHTTP implements [http.Handler].
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	now := time.Now()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.refresh(now)
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := e.write(w, now); err != nil {
+		log.Printf("%s", err)
+	}
+}
+
+// refresh starts background refreshes of the prayer times of the cities that are due and not being refreshed.
+func (e *Exporter) refresh(now time.Time) {
+	if e.cities == nil {
+		e.cities = make(map[int]*cityState)
+	}
+	for _, id := range e.Cities {
+		state, ok := e.cities[id]
+		if !ok {
+			state = &cityState{}
+			e.cities[id] = state
+		}
+		if state.refreshing || now.Before(state.retry) {
+			continue
+		}
+		if now.Sub(state.refreshed) >= e.interval() || len(state.prayers) == 0 || !now.Before(state.prayers[len(state.prayers)-1].Time) {
+			state.refreshing = true
+			go e.refreshCity(id, state)
+		}
+	}
+}
+
+// refreshCity requests the prayer times of the city, bounded by refreshTimeout, and updates its state.
+func (e *Exporter) refreshCity(id int, state *cityState) {
+	ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+	defer cancel()
+	prayers, err := e.prayers(ctx, id)
+	now := time.Now()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	state.refreshing = false
+	if err != nil {
+		log.Printf("%s; keeping previous prayer times", err)
+		state.errors++
+		state.failures++
+		state.retry = now.Add(min(retryInterval<<min(state.failures-1, 16), e.interval()))
+		return
+	}
+	state.prayers, state.refreshed = prayers, now
+	state.failures, state.retry = 0, time.Time{}
+}
+
+// interval returns the refresh interval.
+func (e *Exporter) interval() time.Duration {
+	if e.RefreshInterval <= 0 {
+		return DefaultRefreshInterval
+	}
+	return e.RefreshInterval
+}
+
+// prayers returns the prayers of the weekly prayer times of the city in chronological order, requested in ctx.
+func (e *Exporter) prayers(ctx context.Context, id int) ([]diyanet.Prayer, error) {
+	times, err := e.Client.WithContext(ctx).City(id).GetPrayerTimeWeekly(e.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	timetable := diyanet.NewTimetable(times)
+
+	var prayers []diyanet.Prayer
+	for _, pt := range timetable {
+		day, err := pt.Prayers()
+		if err != nil {
+			return nil, err
+		}
+		prayers = append(prayers, day...)
+	}
+	return prayers, nil
+}
+
+// write writes the metrics in the Prometheus text format.
+func (e *Exporter) write(w io.Writer, now time.Time) error {
+	bw := bufio.NewWriter(w)
+	metric := func(name, typ, help string, samples func(sample func(labels string, value float64))) {
+		fmt.Fprintf(bw, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+		samples(func(labels string, value float64) {
+			fmt.Fprintf(bw, "%s{%s} %s\n", name, labels, strconv.FormatFloat(value, 'f', -1, 64))
+		})
+	}
+	metric("diyanet_seconds_until_next_prayer", "gauge", "Seconds until the next occurrence of the prayer.",
+		func(sample func(string, float64)) {
+			for _, id := range e.Cities {
+				state := e.cities[id]
+				for _, name := range diyanet.PrayerNames {
+					for _, prayer := range state.prayers {
+						if prayer.Name == name && prayer.Time.After(now) {
+							sample(fmt.Sprintf("city=%q,prayer=%q", strconv.Itoa(id), name), prayer.Time.Sub(now).Seconds())
+							break
+						}
+					}
+				}
+			}
+		})
+
+	metric("diyanet_fasting_seconds_remaining", "gauge", "Seconds until Maghrib between Fajr and Maghrib, 0 otherwise.",
+		func(sample func(string, float64)) {
+			for _, id := range e.Cities {
+				state := e.cities[id]
+				if len(state.prayers) == 0 {
+					continue
+				}
+				remaining := 0.0
+				for i, prayer := range state.prayers {
+					if prayer.Name != diyanet.Maghrib || !prayer.Time.After(now) {
+						continue
+					}
+					// The Fajr of the day precedes its Maghrib by four prayers.
+					if i >= 4 && state.prayers[i-4].Name == diyanet.Fajr && !now.Before(state.prayers[i-4].Time) {
+						remaining = prayer.Time.Sub(now).Seconds()
+					}
+					break
+				}
+				sample(fmt.Sprintf("city=%q", strconv.Itoa(id)), remaining)
+			}
+		})
+
+	metric("diyanet_prayer_times_last_refresh_timestamp_seconds", "gauge", "Unix time of the last successful refresh of the prayer times.",
+		func(sample func(string, float64)) {
+			for _, id := range e.Cities {
+				if state := e.cities[id]; !state.refreshed.IsZero() {
+					sample(fmt.Sprintf("city=%q", strconv.Itoa(id)), float64(state.refreshed.Unix()))
+				}
+			}
+		})
+
+	metric("diyanet_prayer_times_coverage_seconds", "gauge", "Seconds until the last prayer held; negative if the prayer times are exhausted.",
+		func(sample func(string, float64)) {
+			for _, id := range e.Cities {
+				if state := e.cities[id]; len(state.prayers) > 0 {
+					sample(fmt.Sprintf("city=%q", strconv.Itoa(id)), state.prayers[len(state.prayers)-1].Time.Sub(now).Seconds())
+				}
+			}
+		})
+
+	metric("diyanet_refresh_errors_total", "counter", "Failed refreshes of the prayer times.",
+		func(sample func(string, float64)) {
+			for _, id := range e.Cities {
+				sample(fmt.Sprintf("city=%q", strconv.Itoa(id)), float64(e.cities[id].errors))
+			}
+		})
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write metrics: %w", err)
+	}
+	return nil
+}
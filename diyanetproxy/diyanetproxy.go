@@ -0,0 +1,208 @@
+// Package diyanetproxy provides an HTTP server mirroring the Diyanet Awqat Salah API under the same paths,
+// so that a whole organization shares one account: the server authenticates upstream with the credentials of
+// its client, caches the responses and serves any number of anonymous downstream clients.
+//
+//	client := diyanet.Config{Email: email, Password: password}.NewClient(ctx)
+//	http.ListenAndServe(":8080", &diyanetproxy.Server{Client: client, Cache: diyanet.NewMemoryCache()})
+//
+// Downstream clients use the server's URL instead of https://awqatsalah.diyanet.gov.tr/. Logins are answered
+// with a token that is not checked, so unmodified API clients work without credentials.
+package diyanetproxy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	diyanet "github.com/abduelhamit/DiyanetAwqatSalahAPI"
+)
+
+const errorPrefix = "diyanet: "
+
+// DefaultTTL is how long responses of the daily and weekly prayer times are cached by a [Server] when no TTL is given.
+const DefaultTTL = time.Hour
+
+// DefaultMaxAge is how long downstream clients may cache responses when no MaxAge is given.
+const DefaultMaxAge = 5 * time.Minute
+
+// tokenLifetime is the lifetime of the tokens returned to downstream logins.
+const tokenLifetime = 24 * time.Hour
+
+// cachedPrefixes are the paths cached by the server; the client caches places, monthly and Ramadan prayer
+// times and the daily content itself, see [diyanet.Config].
+var cachedPrefixes = []string{"api/PrayerTime/Daily/", "api/PrayerTime/Weekly/"}
+
+// mirroredPrefixes are the paths of the API served by the server.
+var mirroredPrefixes = []string{"api/Place/", "api/PrayerTime/", "api/DailyContent"}
+
+// Server is an [http.Handler] mirroring the Diyanet Awqat Salah API. Concurrent requests for the same path
+// are sent upstream once. The zero value is not usable; set at least Client.
+type Server struct {
+	// Client makes the upstream requests with the organization's credentials. Its cache, configured by
+	// [diyanet.Config], holds places, monthly and Ramadan prayer times and the daily content.
+	Client diyanet.Client
+	// Cache holds the daily and weekly prayer times, which the client does not cache; nil for none.
+	Cache diyanet.Cache
+	// TTL is how long Cache holds responses. Defaults to [DefaultTTL].
+	TTL time.Duration
+	// MaxAge is how long downstream clients may cache responses, sent as Cache-Control header.
+	// Defaults to [DefaultMaxAge]; negative values disable caching downstream.
+	MaxAge time.Duration
+
+	mu       sync.Mutex
+	inflight map[string]*call
+}
+
+// call is an upstream request in progress, waited for by concurrent requests for the same path.
+type call struct {
+	done chan struct{}
+	body []byte
+	err  error
+}
+
+// ServeHTTP implements [http.Handler].
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	switch {
+	case path == "Auth/Login" && r.Method == http.MethodPost,
+		strings.HasPrefix(path, "Auth/RefreshToken/") && r.Method == http.MethodGet:
+		s.serveToken(w)
+		return
+	case !mirrored(path):
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	case r.Method != http.MethodGet && r.Method != http.MethodHead:
+		w.Header().Set("Allow", "GET, HEAD")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	body, err := s.get(r.Context(), path)
+	if err != nil {
+		log.Printf("%s; serving error", err)
+		writeError(w, http.StatusBadGateway, "upstream request failed")
+		return
+	}
+
+	var result diyanet.Result[json.RawMessage]
+	if json.Unmarshal(body, &result) != nil {
+		log.Printf("%sinvalid upstream response for %s; serving error", errorPrefix, path)
+		writeError(w, http.StatusBadGateway, "invalid upstream response")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if !result.Ok {
+		w.WriteHeader(http.StatusBadGateway)
+	} else if maxAge := s.maxAge(); maxAge > 0 {
+		w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(maxAge.Seconds())))
+	}
+	w.Write(body)
+}
+
+// get returns the upstream response for path, from the cache if held there.
+func (s *Server) get(ctx context.Context, path string) ([]byte, error) {
+	key := "diyanetproxy:" + path
+	cached := s.Cache != nil && isCached(path)
+	if cached {
+		if body, ok := s.Cache.Get(ctx, key); ok {
+			return body, nil
+		}
+	}
+
+	s.mu.Lock()
+	if c, ok := s.inflight[path]; ok {
+		s.mu.Unlock()
+		select {
+		case <-c.done:
+			return c.body, c.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if s.inflight == nil {
+		s.inflight = make(map[string]*call)
+	}
+	c := &call{done: make(chan struct{})}
+	s.inflight[path] = c
+	s.mu.Unlock()
+
+	c.body, c.err = s.Client.GetRaw(path)
+	if c.err == nil && cached && successful(c.body) {
+		ttl := s.TTL
+		if ttl <= 0 {
+			ttl = DefaultTTL
+		}
+		s.Cache.Set(ctx, key, c.body, ttl)
+	}
+
+	s.mu.Lock()
+	delete(s.inflight, path)
+	s.mu.Unlock()
+	close(c.done)
+	return c.body, c.err
+}
+
+// serveToken answers a login or token refresh with an unsigned token, which the server does not check.
+// It carries an expiry, since API clients schedule refreshes from it.
+func (s *Server) serveToken(w http.ResponseWriter) {
+	encode := func(v any) string {
+		data, _ := json.Marshal(v)
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	token := encode(map[string]string{"alg": "none", "typ": "JWT"}) + "." +
+		encode(map[string]any{"sub": "diyanetproxy", "exp": time.Now().Add(tokenLifetime).Unix()}) + "."
+
+	data := struct {
+		AccessToken  string `json:"accessToken"`
+		RefreshToken string `json:"refreshToken"`
+	}{token, token}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(diyanet.Result[any]{Data: data, Ok: true})
+}
+
+// maxAge returns how long downstream clients may cache responses.
+func (s *Server) maxAge() time.Duration {
+	if s.MaxAge == 0 {
+		return DefaultMaxAge
+	}
+	return s.MaxAge
+}
+
+// writeError writes an error in the response envelope of the API.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(diyanet.Result[any]{Error: message})
+}
+
+// mirrored reports whether the server serves the path.
+func mirrored(path string) bool {
+	for _, prefix := range mirroredPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCached reports whether the server caches responses for the path.
+func isCached(path string) bool {
+	for _, prefix := range cachedPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// successful reports whether body is a successful response envelope.
+func successful(body []byte) bool {
+	var result diyanet.Result[json.RawMessage]
+	return json.Unmarshal(body, &result) == nil && result.Ok
+}
@@ -0,0 +1,271 @@
+package diyanetserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	diyanet "github.com/abduelhamit/DiyanetAwqatSalahAPI"
+)
+
+// schema is a schema object of the OpenAPI document.
+type schema = map[string]any
+
+// operation describes a route in the OpenAPI document.
+type operation struct {
+	id, summary, description string
+	params                   []parameter
+	response                 response
+	// errors are the statuses of the error responses, answered with an [Error].
+	errors []int
+}
+
+// parameter is a parameter of an [operation].
+type parameter struct {
+	name, in, description string
+	required              bool
+	schema                schema
+}
+
+// response is the successful response of an [operation].
+type response struct {
+	// status defaults to 200.
+	status      int
+	description string
+	// contentType defaults to application/json.
+	contentType string
+	// body is a value of the type of the response body, whose schema is derived from its JSON encoding.
+	body any
+	// messages are values of the types of the messages exchanged after the response, e.g. events,
+	// whose schemas are added to the document.
+	messages []any
+}
+
+var (
+	dateSchema = schema{"type": "string", "format": "date"}
+	idSchema   = schema{"type": "integer", "minimum": 1}
+
+	cityIDParam   = parameter{name: "id", in: "path", required: true, description: "ID of the city.", schema: idSchema}
+	cityParam     = parameter{name: "city", in: "query", required: true, description: "ID of the city.", schema: idSchema}
+	timeZoneParam = parameter{name: "tz", in: "query", schema: schema{"type": "string"},
+		description: "IANA time zone selecting the dates and the zone of the times, e.g. Europe/Istanbul. Defaults to the zone of the city."}
+)
+
+// prayerTimeSchema is the schema of the JSON encoding of [diyanet.PrayerTime], see [diyanet.PrayerTime.MarshalJSON].
+var prayerTimeSchema = func() schema {
+	properties := schema{
+		"hijriDateLongIso8601":     schema{"type": "string", "format": "date-time"},
+		"gregorianDateLongIso8601": schema{"type": "string", "format": "date-time"},
+		"greenwichMeanTimeZone":    schema{"type": "number"},
+		"cityId":                   schema{"type": "integer"},
+		"timeZone":                 schema{"type": "string", "example": "Europe/Istanbul"},
+	}
+	for _, name := range []string{"shapeMoonUrl", "fajr", "sunrise", "dhuhr", "asr", "maghrib", "isha", "astronomicalSunset",
+		"astronomicalSunrise", "hijriDateShort", "hijriDateLong", "qiblaTime", "gregorianDateShort", "gregorianDateLong"} {
+		properties[name] = schema{"type": "string"}
+	}
+	times := schema{}
+	for _, name := range diyanet.PrayerNames {
+		times[name.String()] = schema{"type": "string", "format": "date-time"}
+	}
+	properties["times"] = schema{"type": "object", "description": "Instant of every prayer, keyed by prayer name.", "properties": times}
+	return schema{
+		"type":        "object",
+		"description": "Prayer times of a day as published, with the normalized instants of the prayers.",
+		"properties":  properties,
+	}
+}()
+
+// openAPI is the OpenAPI 3 document of the routes, built from the route table on initialization.
+var openAPI []byte
+
+func init() {
+	openAPI = buildOpenAPI()
+}
+
+// buildOpenAPI returns the OpenAPI 3 document of the described routes, with the schemas of their response and
+// message types derived from the types' JSON encoding.
+func buildOpenAPI() []byte {
+	schemas := schema{}
+	paths := schema{}
+	for _, route := range routes {
+		op := route.doc
+		if op == nil {
+			continue
+		}
+
+		resp := op.response
+		status, contentType := resp.status, resp.contentType
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		success := schema{"description": resp.description}
+		if resp.body != nil {
+			success["content"] = schema{contentType: schema{"schema": schemaOf(reflect.TypeOf(resp.body), schemas)}}
+		}
+		for _, message := range resp.messages {
+			schemaOf(reflect.TypeOf(message), schemas)
+		}
+		responses := schema{strconv.Itoa(status): success}
+		for _, status := range op.errors {
+			responses[strconv.Itoa(status)] = schema{"$ref": "#/components/responses/Error"}
+		}
+
+		doc := schema{"operationId": op.id, "summary": op.summary, "responses": responses}
+		if op.description != "" {
+			doc["description"] = op.description
+		}
+		if len(op.params) > 0 {
+			params := make([]schema, len(op.params))
+			for i, p := range op.params {
+				params[i] = schema{"name": p.name, "in": p.in, "description": p.description, "schema": p.schema}
+				if p.required {
+					params[i]["required"] = true
+				}
+			}
+			doc["parameters"] = params
+		}
+
+		path, ok := paths[route.path].(schema)
+		if !ok {
+			path = schema{}
+			paths[route.path] = path
+		}
+		path[strings.ToLower(route.method)] = doc
+	}
+
+	errorResponse := schema{
+		"description": "The request failed: 4xx for invalid requests, 502 if the Diyanet Awqat Salah API failed, 503 if it is unreachable.",
+		"content":     schema{"application/json": schema{"schema": schemaOf(reflect.TypeFor[Error](), schemas)}},
+	}
+	// The document consists of maps, slices, strings and numbers, which always encode.
+	doc, _ := json.MarshalIndent(schema{
+		"openapi": "3.0.3",
+		"info": schema{
+			"title":       "Diyanet Awqat Salah REST API",
+			"description": "Prayer times, next prayer, Qibla direction and daily content of the Diyanet Awqat Salah API.",
+			"version":     "1.0.0",
+		},
+		"paths": paths,
+		"components": schema{
+			"responses": schema{"Error": errorResponse},
+			"schemas":   schemas,
+		},
+	}, "", "  ")
+	return doc
+}
+
+// schemaOf returns the schema of the JSON encoding of t. Named struct types and the prayer types are added to
+// schemas and referenced.
+func schemaOf(t reflect.Type, schemas schema) schema {
+	ref := func(name string, build func() schema) schema {
+		if _, ok := schemas[name]; !ok {
+			schemas[name] = nil // guards against recursion
+			schemas[name] = build()
+		}
+		return schema{"$ref": "#/components/schemas/" + name}
+	}
+
+	switch t {
+	case reflect.TypeFor[time.Time]():
+		return schema{"type": "string", "format": "date-time"}
+	case reflect.TypeFor[diyanet.PrayerTime]():
+		return ref("PrayerTime", func() schema { return prayerTimeSchema })
+	case reflect.TypeFor[diyanet.PrayerName]():
+		return ref("PrayerName", func() schema {
+			names := make([]string, len(diyanet.PrayerNames))
+			for i, name := range diyanet.PrayerNames {
+				names[i] = name.String()
+			}
+			return schema{"type": "string", "enum": names}
+		})
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return schema{"type": "integer"}
+	case reflect.Int64, reflect.Uint64:
+		return schema{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return schema{"type": "number"}
+	case reflect.String:
+		return schema{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return schema{"type": "array", "items": schemaOf(t.Elem(), schemas)}
+	case reflect.Pointer:
+		return schemaOf(t.Elem(), schemas)
+	case reflect.Struct:
+		return ref(t.Name(), func() schema {
+			properties := schema{}
+			var required []string
+			for _, field := range reflect.VisibleFields(t) {
+				if !field.IsExported() || field.Anonymous {
+					continue
+				}
+				name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+				opts := strings.Split(options, ",")
+				if name == "-" {
+					continue
+				}
+				if name == "" {
+					name = field.Name
+				}
+				properties[name] = schemaOf(field.Type, schemas)
+				if !slices.Contains(opts, "omitempty") && !slices.Contains(opts, "omitzero") {
+					required = append(required, name)
+				}
+			}
+			s := schema{"type": "object", "properties": properties}
+			if len(required) > 0 {
+				s["required"] = required
+			}
+			return s
+		})
+	}
+	return schema{}
+}
+
+// swaggerUI is the page of GET /docs, rendering the OpenAPI document with Swagger UI from a CDN.
+const swaggerUI = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Diyanet Awqat Salah REST API</title>
+<link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+<script>
+window.ui = SwaggerUIBundle({url: "openapi.json", dom_id: "#swagger-ui"});
+</script>
+</body>
+</html>
+`
+
+// OpenAPI returns the OpenAPI 3 document of the REST API in JSON, from which clients in other languages can be
+// generated. It is built from the server's routes and response types. The server serves it at /openapi.json.
+func OpenAPI() []byte {
+	return slices.Clone(openAPI)
+}
+
+func (s *Server) openAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Write(openAPI)
+}
+
+func (s *Server) docs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(swaggerUI))
+}
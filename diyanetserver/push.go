@@ -0,0 +1,188 @@
+package diyanetserver
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+
+	diyanet "github.com/abduelhamit/DiyanetAwqatSalahAPI"
+)
+
+// maxSubscriptions is the number of cities a WebSocket connection may subscribe to.
+const maxSubscriptions = 10
+
+// Command is a message sent by clients over GET /v1/ws.
+type Command struct {
+	// Type is "subscribe" to receive the events of the city, or "unsubscribe" to stop them. Subscribing to a
+	// city again replaces its time zone.
+	Type string `json:"type"`
+	// City is the ID of the city.
+	City int `json:"city"`
+	// TZ is an IANA time zone for the times of the city's events, as the tz parameter of the other routes.
+	TZ string `json:"tz,omitempty"`
+}
+
+// Event is a message pushed to clients over GET /v1/ws.
+type Event struct {
+	// Type is "prayer" when a prayer begins, "tick" for the countdown to the next prayer, sent on subscription,
+	// every full minute and after each prayer event, or "error" for a failed command or subscription.
+	Type   string `json:"type"`
+	CityID int    `json:"cityId,omitempty"`
+	// Prayer and Time are the prayer that began or, for ticks, the next prayer.
+	Prayer           string    `json:"prayer,omitempty"`
+	Time             time.Time `json:"time,omitzero"`
+	RemainingSeconds int64     `json:"remainingSeconds,omitempty"`
+	Error            string    `json:"error,omitempty"`
+}
+
+// subscription is a city subscribed to by a WebSocket connection.
+type subscription struct {
+	city  diyanet.City
+	tz    *time.Location
+	times []diyanet.PrayerTime
+	next  diyanet.UpcomingPrayer
+}
+
+// advance updates the next prayer after now, requesting the prayer times of today and tomorrow again when
+// they hold no upcoming prayer.
+func (sub *subscription) advance(now time.Time) error {
+	next, err := diyanet.NextPrayer(sub.times, now)
+	if errors.Is(err, diyanet.ErrNoUpcomingPrayer) {
+		if sub.times, err = upcomingPrayerTimes(sub.city, sub.tz); err != nil {
+			return err
+		}
+		next, err = diyanet.NextPrayer(sub.times, now)
+	}
+	if err != nil {
+		return err
+	}
+	sub.next = next
+	return nil
+}
+
+// tick returns the tick event of the subscription at now.
+func (sub *subscription) tick(now time.Time) Event {
+	return Event{
+		Type:             "tick",
+		CityID:           sub.city.Id,
+		Prayer:           sub.next.Name.String(),
+		Time:             sub.next.Time,
+		RemainingSeconds: int64(sub.next.Time.Sub(now) / time.Second),
+	}
+}
+
+// push serves GET /v1/ws, pushing the [Event] values of the cities subscribed to by [Command] values.
+func (s *Server) push(w http.ResponseWriter, r *http.Request) {
+	// The API is public and uses no cookies, so pages of any origin may connect.
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{InsecureSkipVerify: true})
+	if err != nil {
+		return
+	}
+	defer conn.CloseNow()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	commands := make(chan Command)
+	go func() {
+		defer cancel()
+		for {
+			var cmd Command
+			if err := wsjson.Read(ctx, conn, &cmd); err != nil {
+				return
+			}
+			select {
+			case commands <- cmd:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	subs := make(map[int]*subscription)
+	send := func(event Event) bool {
+		if err := wsjson.Write(ctx, conn, event); err != nil {
+			cancel()
+			return false
+		}
+		return true
+	}
+	subscribe := func(cmd Command, now time.Time) bool {
+		switch {
+		case cmd.City <= 0:
+			return send(Event{Type: "error", Error: "missing or invalid city"})
+		case cmd.Type == "unsubscribe":
+			delete(subs, cmd.City)
+			return true
+		case cmd.Type != "subscribe":
+			return send(Event{Type: "error", CityID: cmd.City, Error: "unknown command type " + cmd.Type})
+		case subs[cmd.City] == nil && len(subs) >= maxSubscriptions:
+			return send(Event{Type: "error", CityID: cmd.City, Error: "too many subscriptions"})
+		}
+
+		sub := &subscription{city: s.client.WithContext(ctx).City(cmd.City)}
+		if cmd.TZ != "" {
+			var err error
+			if sub.tz, err = time.LoadLocation(cmd.TZ); err != nil {
+				return send(Event{Type: "error", CityID: cmd.City, Error: "unknown time zone " + cmd.TZ})
+			}
+		}
+		if err := sub.advance(now); err != nil {
+			log.Printf("%s; rejecting subscription", err)
+			return send(Event{Type: "error", CityID: cmd.City, Error: err.Error()})
+		}
+		subs[cmd.City] = sub
+		return send(sub.tick(now))
+	}
+
+	lastTick := time.Now().Truncate(time.Minute)
+	timer := time.NewTimer(time.Until(lastTick.Add(time.Minute)))
+	defer timer.Stop()
+	for {
+		select {
+		case cmd := <-commands:
+			if !subscribe(cmd, time.Now()) {
+				return
+			}
+		case <-timer.C:
+			now := time.Now()
+			minute := now.Truncate(time.Minute)
+			ticked := minute.After(lastTick)
+			lastTick = minute
+			for id, sub := range subs {
+				began := !now.Before(sub.next.Time)
+				if began {
+					if !send(Event{Type: "prayer", CityID: id, Prayer: sub.next.Name.String(), Time: sub.next.Time}) {
+						return
+					}
+					if err := sub.advance(now); err != nil {
+						log.Printf("%s; ending subscription", err)
+						delete(subs, id)
+						if !send(Event{Type: "error", CityID: id, Error: err.Error()}) {
+							return
+						}
+						continue
+					}
+				}
+				if (began || ticked) && !send(sub.tick(now)) {
+					return
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+
+		// Wake for the next full minute or the next prayer, whichever is earlier.
+		wake := lastTick.Add(time.Minute)
+		for _, sub := range subs {
+			if sub.next.Time.Before(wake) {
+				wake = sub.next.Time
+			}
+		}
+		timer.Reset(time.Until(wake))
+	}
+}
@@ -0,0 +1,352 @@
+// Package diyanetserver provides a REST API over the Diyanet Awqat Salah API with resource-oriented routes,
+// so that the prayer times can be consumed from JavaScript, mobile apps and other languages without Go:
+//
+//	GET /v1/cities/{id}                          city detail
+//	GET /v1/cities/{id}/prayertimes?from=&to=    prayer times of the days from and to (YYYY-MM-DD), default today
+//	GET /v1/next-prayer?city=                    next prayer of the city
+//	GET /v1/qibla?lat=&lon=                      Qibla direction and distance from the coordinates
+//	GET /v1/daily-content                        verse, hadith and prayer of the day
+//	GET /v1/stream?city=                         Server-Sent Events counting down to the next prayer
+//	GET /v1/ws                                   WebSocket pushing prayer events of the subscribed cities
+//	GET /openapi.json                            OpenAPI 3 document of the routes, see [OpenAPI]
+//	GET /docs                                    Swagger UI of the OpenAPI document
+//
+// Prayer times are returned in the JSON schema of [diyanet.PrayerTime], including the normalized instants of
+// the prayers. The prayer time routes accept a tz parameter with an IANA time zone, which selects the dates and
+// the zone of the times; otherwise the zone of the API's GMT offset or the client's [diyanet.TimezoneResolver]
+// is used. Errors are returned as {"error": "..."} with a 4xx or 5xx status.
+package diyanetserver
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	diyanet "github.com/abduelhamit/DiyanetAwqatSalahAPI"
+)
+
+const errorPrefix = "diyanet: "
+
+// Server is an [http.Handler] serving the REST API. Create it with [New].
+type Server struct {
+	client diyanet.Client
+	mux    *http.ServeMux
+}
+
+// route is a route of the REST API.
+type route struct {
+	method, path string
+	handler      func(*Server, http.ResponseWriter, *http.Request)
+	// doc describes the route in the OpenAPI document, see [OpenAPI]; routes without are not described.
+	doc *operation
+}
+
+// routes are the routes served by a [Server], from which the OpenAPI document is built.
+var routes = []route{
+	{"GET", "/v1/cities/{id}", (*Server).city, &operation{
+		id:       "getCity",
+		summary:  "City detail",
+		params:   []parameter{cityIDParam},
+		response: response{description: "The city.", body: City{}},
+		errors:   []int{http.StatusNotFound, http.StatusBadGateway, http.StatusServiceUnavailable},
+	}},
+	{"GET", "/v1/cities/{id}/prayertimes", (*Server).prayerTimes, &operation{
+		id:          "getPrayerTimes",
+		summary:     "Prayer times of the days from and to",
+		description: "Dates lie within the next " + strconv.Itoa(diyanet.MaxPrayerTimeRangeDays) + " days. Without from and to, the prayer times of today are returned.",
+		params: []parameter{
+			cityIDParam,
+			{name: "from", in: "query", description: "First day, defaults to today.", schema: dateSchema},
+			{name: "to", in: "query", description: "Last day, defaults to from.", schema: dateSchema},
+			timeZoneParam,
+		},
+		response: response{description: "The prayer times, one per day.", body: []diyanet.PrayerTime{}},
+		errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusBadGateway, http.StatusServiceUnavailable},
+	}},
+	{"GET", "/v1/next-prayer", (*Server).nextPrayer, &operation{
+		id:       "getNextPrayer",
+		summary:  "Next prayer of a city",
+		params:   []parameter{cityParam, timeZoneParam},
+		response: response{description: "The next prayer.", body: NextPrayer{}},
+		errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusBadGateway, http.StatusServiceUnavailable},
+	}},
+	{"GET", "/v1/qibla", (*Server).qibla, &operation{
+		id:      "getQibla",
+		summary: "Qibla direction and distance from coordinates",
+		params: []parameter{
+			{name: "lat", in: "query", required: true, description: "Latitude in degrees.", schema: schema{"type": "number", "minimum": -90, "maximum": 90}},
+			{name: "lon", in: "query", required: true, description: "Longitude in degrees.", schema: schema{"type": "number", "minimum": -180, "maximum": 180}},
+		},
+		response: response{description: "The Qibla.", body: Qibla{}},
+		errors:   []int{http.StatusBadRequest},
+	}},
+	{"GET", "/v1/daily-content", (*Server).dailyContent, &operation{
+		id:       "getDailyContent",
+		summary:  "Verse, hadith and prayer of the day",
+		response: response{description: "The daily content.", body: DailyContent{}},
+		errors:   []int{http.StatusBadGateway, http.StatusServiceUnavailable},
+	}},
+	{"GET", "/v1/stream", (*Server).stream, &operation{
+		id:      "streamNextPrayer",
+		summary: "Server-Sent Events counting down to the next prayer of a city",
+		description: "Sends a next-prayer event with a NextPrayer when connected and whenever a prayer begins, and a remaining event " +
+			"with a Remaining every second in between. An error event with an Error ends the stream.",
+		params: []parameter{cityParam, timeZoneParam},
+		response: response{description: "The event stream.", contentType: "text/event-stream", body: "",
+			messages: []any{NextPrayer{}, Remaining{}}},
+		errors: []int{http.StatusBadRequest, http.StatusBadGateway, http.StatusServiceUnavailable},
+	}},
+	{"GET", "/v1/ws", (*Server).push, &operation{
+		id:      "pushEvents",
+		summary: "WebSocket pushing prayer events of the subscribed cities",
+		description: "Clients send Command messages to subscribe to cities and receive Event messages: a prayer event when a prayer " +
+			"begins, and a tick event with the next prayer on subscription, every full minute and after each prayer event. " +
+			"A connection may subscribe to " + strconv.Itoa(maxSubscriptions) + " cities.",
+		response: response{status: http.StatusSwitchingProtocols, description: "Switched to the WebSocket protocol.",
+			messages: []any{Command{}, Event{}}},
+	}},
+	{"GET", "/openapi.json", (*Server).openAPI, nil},
+	{"GET", "/docs", (*Server).docs, nil},
+}
+
+// New returns a server answering requests with the client, whose cache configuration (see [diyanet.Config])
+// determines how often the Diyanet Awqat Salah API is asked.
+func New(client diyanet.Client) *Server {
+	s := &Server{client: client, mux: http.NewServeMux()}
+	for _, route := range routes {
+		s.mux.HandleFunc(route.method+" "+route.path, func(w http.ResponseWriter, r *http.Request) {
+			route.handler(s, w, r)
+		})
+	}
+	return s
+}
+
+// ServeHTTP implements [http.Handler].
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// City is the response of GET /v1/cities/{id}.
+type City struct {
+	ID              int     `json:"id"`
+	Code            string  `json:"code"`
+	Name            string  `json:"name"`
+	NameEn          string  `json:"nameEn,omitempty"`
+	Country         string  `json:"country"`
+	CountryEn       string  `json:"countryEn,omitempty"`
+	QiblaAngle      float64 `json:"qiblaAngle"`
+	DistanceToKaaba string  `json:"distanceToKaaba"`
+}
+
+// NextPrayer is the response of GET /v1/next-prayer.
+type NextPrayer struct {
+	CityID           int                `json:"cityId"`
+	Prayer           diyanet.PrayerName `json:"prayer"`
+	Time             time.Time          `json:"time"`
+	RemainingSeconds int64              `json:"remainingSeconds"`
+}
+
+// Qibla is the response of GET /v1/qibla.
+type Qibla struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Direction is the bearing of the Qibla in degrees clockwise from true north.
+	Direction float64 `json:"direction"`
+	// DistanceKm is the great-circle distance to the Kaaba in kilometers.
+	DistanceKm float64 `json:"distanceKm"`
+}
+
+// DailyContent is the response of GET /v1/daily-content.
+type DailyContent struct {
+	DayOfYear    int    `json:"dayOfYear"`
+	Verse        string `json:"verse"`
+	VerseSource  string `json:"verseSource"`
+	Hadith       string `json:"hadith"`
+	HadithSource string `json:"hadithSource"`
+	Prayer       string `json:"prayer"`
+	PrayerSource string `json:"prayerSource"`
+}
+
+// Error is the response of failed requests.
+type Error struct {
+	Error string `json:"error"`
+}
+
+func (s *Server) city(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusNotFound, "unknown city")
+		return
+	}
+	detail, err := s.client.WithContext(r.Context()).GetCityDetail(id)
+	if err != nil {
+		upstreamError(w, err)
+		return
+	}
+
+	angle, _ := detail.QiblaAngleDegrees()
+	writeJSON(w, City{
+		ID:              id,
+		Code:            detail.Code,
+		Name:            detail.LocalizedName(diyanet.Turkish),
+		NameEn:          detail.CityEn,
+		Country:         detail.LocalizedCountryName(diyanet.Turkish),
+		CountryEn:       detail.CountryEn,
+		QiblaAngle:      angle,
+		DistanceToKaaba: detail.DistanceToKaaba,
+	})
+}
+
+func (s *Server) prayerTimes(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusNotFound, "unknown city")
+		return
+	}
+	tz, ok := timezone(w, r)
+	if !ok {
+		return
+	}
+
+	city := s.client.WithContext(r.Context()).City(id)
+	from, to, err := city.ParsePrayerTimeRange(r.URL.Query().Get("from"), r.URL.Query().Get("to"), tz)
+	if errors.Is(err, diyanet.ErrInvalidPrayerTimeRange) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		upstreamError(w, err)
+		return
+	}
+
+	times, err := city.GetPrayerTimeRange(from, to, tz)
+	if err != nil {
+		upstreamError(w, err)
+		return
+	}
+	writeJSON(w, times)
+}
+
+func (s *Server) nextPrayer(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.URL.Query().Get("city"))
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "missing or invalid city parameter")
+		return
+	}
+	tz, ok := timezone(w, r)
+	if !ok {
+		return
+	}
+
+	times, err := upcomingPrayerTimes(s.client.WithContext(r.Context()).City(id), tz)
+	if err != nil {
+		upstreamError(w, err)
+		return
+	}
+	now := time.Now()
+	next, err := diyanet.NextPrayer(times, now)
+	if err != nil {
+		upstreamError(w, err)
+		return
+	}
+	writeJSON(w, NextPrayer{
+		CityID:           id,
+		Prayer:           next.Name,
+		Time:             next.Time,
+		RemainingSeconds: int64(next.Remaining / time.Second),
+	})
+}
+
+func (s *Server) qibla(w http.ResponseWriter, r *http.Request) {
+	var at diyanet.Coordinates
+	var err error
+	if at.Latitude, err = strconv.ParseFloat(r.URL.Query().Get("lat"), 64); err != nil || !(at.Latitude >= -90 && at.Latitude <= 90) {
+		writeError(w, http.StatusBadRequest, "missing or invalid lat parameter")
+		return
+	}
+	if at.Longitude, err = strconv.ParseFloat(r.URL.Query().Get("lon"), 64); err != nil || !(at.Longitude >= -180 && at.Longitude <= 180) {
+		writeError(w, http.StatusBadRequest, "missing or invalid lon parameter")
+		return
+	}
+
+	writeJSON(w, Qibla{
+		Latitude:   at.Latitude,
+		Longitude:  at.Longitude,
+		Direction:  at.QiblaDirection(),
+		DistanceKm: at.DistanceTo(diyanet.KaabaCoordinates),
+	})
+}
+
+func (s *Server) dailyContent(w http.ResponseWriter, r *http.Request) {
+	content, err := s.client.WithContext(r.Context()).GetDailyContent()
+	if err != nil {
+		upstreamError(w, err)
+		return
+	}
+	writeJSON(w, DailyContent{
+		DayOfYear:    content.DayOfYear,
+		Verse:        content.Verse,
+		VerseSource:  content.VerseSource,
+		Hadith:       content.Hadith,
+		HadithSource: content.HadithSource,
+		Prayer:       content.Pray,
+		PrayerSource: content.PraySource,
+	})
+}
+
+// timezone returns the time zone of the tz parameter, or nil if absent. It writes an error and reports false
+// if the zone is unknown.
+func timezone(w http.ResponseWriter, r *http.Request) (*time.Location, bool) {
+	name := r.URL.Query().Get("tz")
+	if name == "" {
+		return nil, true
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "unknown time zone "+name)
+		return nil, false
+	}
+	return loc, true
+}
+
+// upcomingPrayerTimes returns the prayer times of today and tomorrow of the city, which hold its next prayer,
+// served from the cached monthly prayer times if available.
+func upcomingPrayerTimes(city diyanet.City, tz *time.Location) ([]diyanet.PrayerTime, error) {
+	today, _, err := city.ParsePrayerTimeRange("", "", tz)
+	if err != nil {
+		return nil, err
+	}
+	return city.GetPrayerTimeRange(today, today.AddDate(0, 0, 1), tz)
+}
+
+// writeJSON writes v as JSON response.
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("%sunable to write response: %s", errorPrefix, err)
+	}
+}
+
+// writeError writes an error response.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(Error{Error: message})
+}
+
+// upstreamError logs err and writes it as error response: as 404 if the prayer times hold no upcoming prayer,
+// as 503 if the client is offline and as 502 otherwise.
+func upstreamError(w http.ResponseWriter, err error) {
+	log.Printf("%s; serving error", err)
+	status := http.StatusBadGateway
+	switch {
+	case errors.Is(err, diyanet.ErrNoUpcomingPrayer):
+		status = http.StatusNotFound
+	case errors.Is(err, diyanet.ErrOffline):
+		status = http.StatusServiceUnavailable
+	}
+	writeError(w, status, err.Error())
+}
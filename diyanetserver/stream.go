@@ -0,0 +1,94 @@
+package diyanetserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	diyanet "github.com/abduelhamit/DiyanetAwqatSalahAPI"
+)
+
+// Remaining is the data of the remaining events of GET /v1/stream.
+type Remaining struct {
+	RemainingSeconds int64 `json:"remainingSeconds"`
+}
+
+// stream serves GET /v1/stream as Server-Sent Events: a next-prayer event with a [NextPrayer] when connected and
+// whenever a prayer begins, and a remaining event with a [Remaining] every second in between. The prayer times
+// of today and tomorrow of the city are requested again when they hold no upcoming prayer.
+func (s *Server) stream(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.URL.Query().Get("city"))
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "missing or invalid city parameter")
+		return
+	}
+	tz, ok := timezone(w, r)
+	if !ok {
+		return
+	}
+
+	city := s.client.WithContext(r.Context()).City(id)
+	times, err := upcomingPrayerTimes(city, tz)
+	if err != nil {
+		upstreamError(w, err)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	// The stream outlives any write timeout of the server.
+	rc.SetWriteDeadline(time.Time{})
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	var current diyanet.UpcomingPrayer
+	for {
+		now := time.Now()
+		next, err := diyanet.NextPrayer(times, now)
+		if errors.Is(err, diyanet.ErrNoUpcomingPrayer) {
+			if times, err = upcomingPrayerTimes(city, tz); err == nil {
+				next, err = diyanet.NextPrayer(times, now)
+			}
+		}
+		if err != nil {
+			log.Printf("%s; closing stream", err)
+			writeEvent(rc, w, "error", Error{Error: err.Error()})
+			return
+		}
+
+		remaining := int64(next.Remaining / time.Second)
+		if next.Name != current.Name || !next.Time.Equal(current.Time) {
+			current = next
+			err = writeEvent(rc, w, "next-prayer", NextPrayer{CityID: id, Prayer: next.Name, Time: next.Time, RemainingSeconds: remaining})
+		} else {
+			err = writeEvent(rc, w, "remaining", Remaining{RemainingSeconds: remaining})
+		}
+		if err != nil {
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// writeEvent writes and flushes a Server-Sent Event with v as JSON data.
+func writeEvent(rc *http.ResponseController, w http.ResponseWriter, event string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
@@ -0,0 +1,12 @@
+package diyanet
+
+// Duha returns the window of the Duha prayer, which lies between the sunrise and the zawal makruh windows:
+// it begins margins.Sunrise after sunrise and ends margins.Zawal before Dhuhr.
+func (pt PrayerTime) Duha(margins KarahatMargins) (Interval, error) {
+	windows, err := pt.KarahatWindows(margins)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	return Interval{Start: windows.Sunrise.End, End: windows.Zawal.Start}, nil
+}
@@ -0,0 +1,173 @@
+package diyanet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"log"
+	"mime"
+	"mime/quotedprintable"
+	"net/smtp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// emailDigestHTML lays out the timetable and daily content of an [EmailDigest].
+var emailDigestHTML = htmltemplate.Must(htmltemplate.New("digest").Parse(
+	`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Title}}</title></head>
+<body>
+<h1>{{.Title}}</h1>
+<table class="timetable">
+  <tr><th>Date</th>{{range .Names}}<th>{{.}}</th>{{end}}</tr>
+{{- range .Days}}
+  <tr><td>{{.Date}}</td>{{range .Clocks}}<td>{{.}}</td>{{end}}</tr>
+{{- end}}
+</table>
+{{- if .Content}}
+{{.Content}}
+{{- end}}
+</body>
+</html>
+`))
+
+// emailDigestDay is a row of the timetable as passed to the template.
+type emailDigestDay struct {
+	Date   string
+	Clocks []string
+}
+
+// EmailDigest sends the timetable of the coming days and the daily content as an HTML email
+// to a list of recipients, for mosques communicating by mailing list. Register it with
+// [Scheduler.OnEmailDigest] to send it daily or weekly, or call [EmailDigest.Send] directly.
+type EmailDigest struct {
+	// Addr is the address of the SMTP server, e.g. "smtp.example.com:587". STARTTLS is used if offered.
+	Addr string
+	// Auth authenticates with the SMTP server, e.g. [smtp.PlainAuth]; nil for no authentication.
+	Auth smtp.Auth
+	// From is the sender address.
+	From string
+	// To are the recipient addresses. With several recipients, they are not disclosed to each other.
+	To []string
+	// Subject is the subject prefix. Defaults to "Prayer times".
+	Subject string
+	// Days is the number of days covered by the digest: 1 for a daily, 7 for a weekly digest. Defaults to 1.
+	Days int
+	// Weekday is the day a digest of several days is sent on.
+	Weekday time.Weekday
+	// Before is how long before Fajr the digest is sent.
+	Before time.Duration
+}
+
+// OnEmailDigest registers the digest to be sent for every city of the scheduler, daily or on the digest's
+// weekday, with the daily content retrieved by the scheduler's client. The email is sent in its own goroutine;
+// errors are logged. Late events (see [PrayerEvent.Late]) are skipped.
+func (s *Scheduler) OnEmailDigest(d EmailDigest) {
+	s.OnReminder(Reminder{Offset: -d.Before, Prayers: []PrayerName{Fajr}}, func(event PrayerEvent) {
+		if event.Late || (d.days() > 1 && event.Prayer.Time.Weekday() != d.Weekday) {
+			return
+		}
+
+		s.mu.Lock()
+		start := event.Prayer.Time
+		days := slices.Clone(s.timetables[event.City.Id].Range(start, start.AddDate(0, 0, d.days()-1)))
+		s.mu.Unlock()
+
+		go func() {
+			content, err := event.City.client.GetDailyContent()
+			if err != nil {
+				log.Printf("%s; sending digest without daily content", err)
+			}
+			if err := d.Send(event.City, days, content); err != nil {
+				log.Printf("%s; digest not sent", err)
+			}
+		}()
+	})
+}
+
+// Send sends the digest with the prayer times of the city on the given days and the daily content, if not nil.
+func (d EmailDigest) Send(city City, days []PrayerTime, content *DailyContent) error {
+	if len(d.To) == 0 {
+		return errors.New(errorPrefix + "email digest without recipients")
+	}
+
+	subject := d.Subject
+	if subject == "" {
+		subject = "Prayer times"
+	}
+	if len(days) > 0 {
+		subject += " " + days[0].GregorianDate.Format("02.01.2006")
+		if len(days) > 1 {
+			subject += " – " + days[len(days)-1].GregorianDate.Format("02.01.2006")
+		}
+	}
+	if city.Name != "" {
+		subject += ", " + city.Name
+	}
+
+	body, err := d.render(subject, days, content)
+	if err != nil {
+		return err
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", d.From)
+	if len(d.To) == 1 {
+		fmt.Fprintf(&msg, "To: %s\r\n", d.To[0])
+	} else {
+		// Recipients of a mailing list do not see each other.
+		msg.WriteString("To: undisclosed-recipients:;\r\n")
+	}
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/html; charset=utf-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+	qp := quotedprintable.NewWriter(&msg)
+	qp.Write(body)
+	qp.Close()
+
+	if err := smtp.SendMail(d.Addr, d.Auth, d.From, d.To, msg.Bytes()); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to send email digest: %w", err)
+	}
+	return nil
+}
+
+// render returns the HTML body of the digest.
+func (d EmailDigest) render(title string, days []PrayerTime, content *DailyContent) ([]byte, error) {
+	data := struct {
+		Title   string
+		Names   []PrayerName
+		Days    []emailDigestDay
+		Content htmltemplate.HTML
+	}{Title: title, Names: PrayerNames[:]}
+
+	for _, day := range days {
+		row := emailDigestDay{Date: day.GregorianDate.Format("Mon 02.01.2006")}
+		for _, name := range PrayerNames {
+			row.Clocks = append(row.Clocks, day.Clock(name))
+		}
+		data.Days = append(data.Days, row)
+	}
+	if content != nil {
+		var fragment strings.Builder
+		if err := content.RenderHTML(&fragment); err != nil {
+			return nil, err
+		}
+		data.Content = htmltemplate.HTML(fragment.String()) // escaped by RenderHTML
+	}
+
+	var body bytes.Buffer
+	if err := emailDigestHTML.Execute(&body, data); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to render email digest: %w", err)
+	}
+	return body.Bytes(), nil
+}
+
+// days returns the number of days covered by the digest.
+func (d EmailDigest) days() int {
+	return max(d.Days, 1)
+}
@@ -0,0 +1,41 @@
+package diyanet
+
+import "math"
+
+const earthRadiusKm = 6371.0088
+
+// Coordinates is a point on the earth's surface in decimal degrees.
+type Coordinates struct {
+	// Latitude is the latitude in degrees, positive north of the equator.
+	Latitude float64
+	// Longitude is the longitude in degrees, positive east of Greenwich.
+	Longitude float64
+}
+
+// DistanceTo returns the great-circle distance to other in kilometers.
+func (c Coordinates) DistanceTo(other Coordinates) float64 {
+	lat1, lat2 := radians(c.Latitude), radians(other.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(other.Longitude - c.Longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// KaabaCoordinates are the coordinates of the Kaaba in Mecca.
+var KaabaCoordinates = Coordinates{Latitude: 21.422487, Longitude: 39.826206}
+
+// QiblaDirection returns the direction of the Qibla as the initial great-circle bearing towards the Kaaba
+// in degrees clockwise from true north, between 0 and 360.
+func (c Coordinates) QiblaDirection() float64 {
+	lat1, lat2 := radians(c.Latitude), radians(KaabaCoordinates.Latitude)
+	dLon := radians(KaabaCoordinates.Longitude - c.Longitude)
+
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,106 @@
+package diyanet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Geocoder resolves free-text addresses to coordinates and coordinates to addresses,
+// for example via [Nominatim].
+type Geocoder interface {
+	// Geocode returns the places matching the address, best match first.
+	Geocode(ctx context.Context, address string) ([]Address, error)
+	// Reverse returns the place at the given coordinates.
+	Reverse(ctx context.Context, at Coordinates) (Address, error)
+}
+
+// Address is a place as resolved by a [Geocoder].
+type Address struct {
+	// Coordinates is the location of the place.
+	Coordinates Coordinates
+	// CountryCode is the ISO 3166-1 alpha-2 code of the country, e.g. "DE".
+	CountryCode string
+	// Country is the name of the country.
+	Country string
+	// State is the name of the state or province.
+	State string
+	// Localities holds the names of the city, town, district and similar units containing the place,
+	// most specific first.
+	Localities []string
+}
+
+// ErrNoGeocoder is returned by methods that need a [Geocoder] if none is configured in [Config.Geocoder].
+var ErrNoGeocoder = errors.New(errorPrefix + "no geocoder configured")
+
+// FindCityByAddress resolves a free-text address with the configured [Geocoder] and returns the
+// Diyanet city that best matches it.
+func (c Client) FindCityByAddress(address string) (City, error) {
+	if c.geocoder == nil {
+		return City{}, ErrNoGeocoder
+	}
+
+	places, err := c.geocoder.Geocode(c.ctx, address)
+	if err != nil {
+		return City{}, fmt.Errorf(errorPrefix+"unable to geocode %q: %w", address, err)
+	}
+	if len(places) == 0 {
+		return City{}, fmt.Errorf(errorPrefix+"address %q not found", address)
+	}
+
+	return c.cityForAddress(places[0])
+}
+
+// NearestCity resolves the coordinates with the configured [Geocoder] and returns the Diyanet city
+// that best matches the place found there.
+func (c Client) NearestCity(at Coordinates) (City, error) {
+	if c.geocoder == nil {
+		return City{}, ErrNoGeocoder
+	}
+
+	place, err := c.geocoder.Reverse(c.ctx, at)
+	if err != nil {
+		return City{}, fmt.Errorf(errorPrefix+"unable to reverse geocode %f,%f: %w", at.Latitude, at.Longitude, err)
+	}
+
+	return c.cityForAddress(place)
+}
+
+// cityForAddress finds the city of the address's country whose name best matches one of its localities,
+// preferring exact matches of more specific localities and falling back to the state name.
+func (c Client) cityForAddress(address Address) (City, error) {
+	country, err := c.CountryByISO(address.CountryCode)
+	if err != nil {
+		return City{}, err
+	}
+
+	// Concat copies, so the state is not written into the backing array of the caller's localities.
+	names := slices.Concat(address.Localities, []string{address.State})
+	var best *CityMatch
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+
+		matches, err := c.FindCity(name, FindCityOptions{Country: country.Code, Limit: 1})
+		if err != nil {
+			return City{}, err
+		}
+		if len(matches) == 0 {
+			continue
+		}
+		if matches[0].Score == 1 {
+			return matches[0].City, nil
+		}
+		if best == nil || matches[0].Score > best.Score {
+			best = &matches[0]
+		}
+	}
+
+	if best == nil {
+		return City{}, fmt.Errorf(errorPrefix+"no city found for %v in country %s (%d – %s)",
+			names, country.Name, country.Id, country.Code)
+	}
+	return best.City, nil
+}
@@ -2,4 +2,19 @@ module github.com/abduelhamit/DiyanetAwqatSalahAPI
 
 go 1.25.5
 
-require golang.org/x/oauth2 v0.34.0
+require (
+	github.com/coder/websocket v1.8.14
+	github.com/graph-gophers/graphql-go v1.9.0
+	go.etcd.io/bbolt v1.4.3
+	golang.org/x/image v0.25.0
+	golang.org/x/oauth2 v0.34.0
+	google.golang.org/grpc v1.79.3
+	google.golang.org/protobuf v1.36.10
+)
+
+require (
+	golang.org/x/net v0.48.0 // indirect
+	golang.org/x/sys v0.39.0 // indirect
+	golang.org/x/text v0.32.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251202230838-ff82c1b0f217 // indirect
+)
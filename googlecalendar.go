@@ -0,0 +1,133 @@
+package diyanet
+
+import (
+	"context"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DefaultGoogleCalendarURL is the URL of the Google Calendar API.
+const DefaultGoogleCalendarURL = "https://www.googleapis.com/calendar/v3/"
+
+// googleEventIDEncoding encodes event IDs with the characters allowed by Google Calendar.
+var googleEventIDEncoding = base32.HexEncoding.WithPadding(base32.NoPadding)
+
+// GoogleCalendar pushes prayer times as events into a Google Calendar through the Calendar API,
+// see https://developers.google.com/calendar/api/v3/reference/events.
+//
+// Event IDs are derived from the city, date and prayer, so repeated syncs update the events
+// instead of duplicating them.
+type GoogleCalendar struct {
+	// HTTPClient authorizes the requests with an OAuth 2.0 token of the calendar's owner with the
+	// https://www.googleapis.com/auth/calendar.events scope, e.g. created with golang.org/x/oauth2/google.
+	HTTPClient *http.Client
+	// CalendarID is the ID of the calendar. Defaults to "primary".
+	CalendarID string
+	// BaseURL is the URL of the Calendar API. Defaults to [DefaultGoogleCalendarURL].
+	BaseURL string
+	// Options selects the prayers and sets the length and reminders of the events; its Name and
+	// RefreshInterval are not used. Alarms become popup reminders.
+	Options ICalOptions
+}
+
+// googleEvent is an event resource of the Calendar API.
+type googleEvent struct {
+	Id           string          `json:"id"`
+	Status       string          `json:"status"`
+	Summary      string          `json:"summary"`
+	Location     string          `json:"location,omitempty"`
+	Start        googleEventTime `json:"start"`
+	End          googleEventTime `json:"end"`
+	Transparency string          `json:"transparency"`
+	Reminders    googleReminders `json:"reminders"`
+}
+
+type googleEventTime struct {
+	DateTime string `json:"dateTime"`
+}
+
+type googleReminders struct {
+	UseDefault bool             `json:"useDefault"`
+	Overrides  []googleReminder `json:"overrides"`
+}
+
+type googleReminder struct {
+	Method  string `json:"method"`
+	Minutes int    `json:"minutes"`
+}
+
+// Sync creates or updates the events of the prayer times of the city and returns the number of events synced.
+// Events failing to sync do not prevent syncing the others; their errors are returned joined.
+func (g GoogleCalendar) Sync(ctx context.Context, city City, times []PrayerTime) (int, error) {
+	prayers, err := sortedPrayers(times)
+	if err != nil {
+		return 0, err
+	}
+	duration := g.Options.Duration
+	if duration <= 0 {
+		duration = DefaultICalEventDuration
+	}
+
+	var (
+		synced int
+		errs   []error
+	)
+	for _, prayer := range prayers {
+		if len(g.Options.Prayers) > 0 && !slices.Contains(g.Options.Prayers, prayer.Name) {
+			continue
+		}
+
+		event := googleEvent{
+			Id:           googleEventID(city.Id, prayer),
+			Status:       "confirmed", // restores events deleted in the calendar
+			Summary:      prayer.Name.String(),
+			Location:     city.Name,
+			Start:        googleEventTime{DateTime: prayer.Time.Format(time.RFC3339)},
+			End:          googleEventTime{DateTime: prayer.Time.Add(duration).Format(time.RFC3339)},
+			Transparency: "transparent",
+			Reminders:    googleReminders{Overrides: []googleReminder{}},
+		}
+		for _, lead := range g.Options.Alarms[prayer.Name] {
+			event.Reminders.Overrides = append(event.Reminders.Overrides,
+				googleReminder{Method: "popup", Minutes: int(lead.Round(time.Minute).Minutes())})
+		}
+
+		if err := g.upsert(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%w (%s on %s)", err, prayer.Name, dateKey(prayer.Time)))
+			continue
+		}
+		synced++
+	}
+	return synced, errors.Join(errs...)
+}
+
+// upsert updates the event, or inserts it if it does not exist yet.
+func (g GoogleCalendar) upsert(ctx context.Context, event googleEvent) error {
+	base := g.BaseURL
+	if base == "" {
+		base = DefaultGoogleCalendarURL
+	}
+	calendarID := g.CalendarID
+	if calendarID == "" {
+		calendarID = "primary"
+	}
+	events := strings.TrimSuffix(base, "/") + "/calendars/" + url.PathEscape(calendarID) + "/events"
+
+	err := sendJSON(ctx, g.HTTPClient, "PUT", "google calendar", events+"/"+event.Id, nil, event, nil)
+	var status *statusError
+	if errors.As(err, &status) && status.resp.StatusCode == http.StatusNotFound {
+		err = sendJSON(ctx, g.HTTPClient, "POST", "google calendar", events, nil, event, nil)
+	}
+	return err
+}
+
+// googleEventID returns the stable event ID of a prayer in a city, made of the characters a–v and 0–9.
+func googleEventID(cityID int, prayer Prayer) string {
+	return strings.ToLower(googleEventIDEncoding.EncodeToString([]byte(icalUID(cityID, prayer))))
+}
@@ -0,0 +1,162 @@
+package diyanet
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultICalEventDuration is the length of the calendar events written by [WriteICal] when none is given.
+const DefaultICalEventDuration = 15 * time.Minute
+
+// icalEscaper escapes the special characters of iCalendar text values.
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// ICalOptions configures the calendar written by [WriteICal].
+type ICalOptions struct {
+	// Name is the name of the calendar shown by calendar applications.
+	Name string
+	// Prayers selects the prayers written as events. If empty, all prayers are written.
+	Prayers []PrayerName
+	// Duration is the length of each event. Defaults to [DefaultICalEventDuration].
+	Duration time.Duration
+	// Alarms attaches reminders to the events of a prayer, given by how long before the prayer they go off,
+	// e.g. {Fajr: {30 * time.Minute}, Maghrib: {time.Hour, 0}}. Imported calendars notify through them.
+	Alarms map[PrayerName][]time.Duration
+	// Iqamah, if set, adds the congregation time of the prayers with a rule to the description of their events.
+	Iqamah IqamahConfig
+	// RefreshInterval, if positive, tells subscribing calendar applications how often to reload the calendar.
+	RefreshInterval time.Duration
+}
+
+// WriteICal writes the prayer times of the city as an iCalendar (RFC 5545) calendar with one event per prayer.
+// Events have stable UIDs derived from the city, date and prayer, so that re-imports update them.
+// Times are written in UTC, so the calendar is independent of time zone definitions.
+func WriteICal(w io.Writer, city City, times []PrayerTime, opts ICalOptions) error {
+	duration := opts.Duration
+	if duration <= 0 {
+		duration = DefaultICalEventDuration
+	}
+
+	prayers, err := sortedPrayers(times)
+	if err != nil {
+		return err
+	}
+
+	iw := icalWriter{w: bufio.NewWriter(w)}
+	iw.line("BEGIN:VCALENDAR")
+	iw.line("VERSION:2.0")
+	iw.line("PRODID:-//DiyanetAwqatSalahAPI//Prayer Times//EN")
+	iw.line("CALSCALE:GREGORIAN")
+	if opts.Name != "" {
+		iw.line("X-WR-CALNAME:" + icalEscaper.Replace(opts.Name))
+	}
+	if opts.RefreshInterval > 0 {
+		iw.line("REFRESH-INTERVAL;VALUE=DURATION:" + icalDuration(opts.RefreshInterval))
+		iw.line("X-PUBLISHED-TTL:" + icalDuration(opts.RefreshInterval))
+	}
+
+	stamp := icalTime(time.Now())
+	for _, prayer := range prayers {
+		if len(opts.Prayers) > 0 && !slices.Contains(opts.Prayers, prayer.Name) {
+			continue
+		}
+
+		iw.line("BEGIN:VEVENT")
+		iw.line("UID:" + icalUID(city.Id, prayer))
+		iw.line("DTSTAMP:" + stamp)
+		iw.line("DTSTART:" + icalTime(prayer.Time))
+		iw.line("DTEND:" + icalTime(prayer.Time.Add(duration)))
+		iw.line("SUMMARY:" + icalEscaper.Replace(prayer.Name.String()))
+		if city.Name != "" {
+			iw.line("LOCATION:" + icalEscaper.Replace(city.Name))
+		}
+		if rule, ok := opts.Iqamah[prayer.Name]; ok {
+			iqamah, err := rule.time(prayer.Time)
+			if err != nil {
+				return fmt.Errorf(errorPrefix+"invalid %s iqamah time: %w", prayer.Name, err)
+			}
+			iw.line("DESCRIPTION:" + icalEscaper.Replace("Iqamah "+iqamah.Format("15:04")))
+		}
+		iw.line("TRANSP:TRANSPARENT")
+		for _, lead := range opts.Alarms[prayer.Name] {
+			description := prayer.Name.String()
+			if lead > 0 {
+				description += fmt.Sprintf(" in %d minutes", int(lead.Round(time.Minute).Minutes()))
+			}
+			iw.line("BEGIN:VALARM")
+			iw.line("ACTION:DISPLAY")
+			iw.line("DESCRIPTION:" + icalEscaper.Replace(description))
+			iw.line("TRIGGER:" + icalDuration(-lead))
+			iw.line("END:VALARM")
+		}
+		iw.line("END:VEVENT")
+	}
+
+	iw.line("END:VCALENDAR")
+	if iw.err != nil {
+		return iw.err
+	}
+	return iw.w.Flush()
+}
+
+// icalUID returns the stable UID of the event of a prayer in a city.
+func icalUID(cityID int, prayer Prayer) string {
+	return fmt.Sprintf("%d-%s-%s@awqatsalah.diyanet.gov.tr",
+		cityID, prayer.Time.Format("20060102"), strings.ToLower(prayer.Name.String()))
+}
+
+// icalTime formats t as an iCalendar UTC date-time.
+func icalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// icalDuration formats d as an iCalendar duration with minute precision, e.g. "-PT1H30M".
+func icalDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	d = d.Round(time.Minute)
+	if d == 0 {
+		return "PT0S"
+	}
+
+	s := sign + "PT"
+	if hours := int(d / time.Hour); hours > 0 {
+		s += fmt.Sprintf("%dH", hours)
+	}
+	if minutes := int(d % time.Hour / time.Minute); minutes > 0 {
+		s += fmt.Sprintf("%dM", minutes)
+	}
+	return s
+}
+
+// icalWriter writes content lines, folded at 75 octets, and keeps the first error.
+type icalWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (iw *icalWriter) line(s string) {
+	if iw.err != nil {
+		return
+	}
+	// Continuation lines start with a space, leaving 74 octets.
+	for limit := 75; len(s) > limit; limit = 74 {
+		// Fold without splitting UTF-8 sequences.
+		n := limit
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+		_, iw.err = iw.w.WriteString(s[:n] + "\r\n ")
+		s = s[n:]
+	}
+	if iw.err == nil {
+		_, iw.err = iw.w.WriteString(s + "\r\n")
+	}
+}
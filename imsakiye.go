@@ -0,0 +1,307 @@
+package diyanet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+	"strings"
+	"time"
+)
+
+// Page geometry of the imsakiye in points: A4 portrait with equal margins.
+const (
+	imsakiyePageWidth  = 595
+	imsakiyePageHeight = 842
+	imsakiyeMargin     = 40
+	imsakiyeRowHeight  = 19
+)
+
+// imsakiyeColumnWidths are the widths of the date, weekday and Hijri date columns;
+// the remaining width is shared by the prayer times.
+var imsakiyeColumnWidths = [...]float64{62, 68, 60}
+
+// imsakiyeEncoding is the font encoding of the imsakiye: WinAnsiEncoding with the Turkish letters
+// at their Windows-1254 code points, so that Turkish city names can be set in the standard fonts.
+var imsakiyeEncoding = map[rune]byte{'Ğ': 0xD0, 'İ': 0xDD, 'Ş': 0xDE, 'ğ': 0xF0, 'ı': 0xFD, 'ş': 0xFE}
+
+// imsakiyeReplaced reports whether the Latin-1 character at code point c is replaced by a Turkish letter.
+func imsakiyeReplaced(c byte) bool {
+	for _, turkish := range imsakiyeEncoding {
+		if turkish == c {
+			return true
+		}
+	}
+	return false
+}
+
+// ImsakiyeOptions configures [Timetable.WriteImsakiye].
+type ImsakiyeOptions struct {
+	// Title heads every page before the month. Defaults to "Imsakiye".
+	Title string
+	// Language selects the language of the city and country name.
+	Language Language
+	// Logo is drawn in the top left corner of every page, scaled to a height of 60 points; nil for none.
+	Logo image.Image
+	// Color is the color of the title and the table header. Defaults to a dark teal.
+	Color color.Color
+	// RamadanColor is the background of the days of Ramadan. Defaults to a light green.
+	RamadanColor color.Color
+}
+
+// WriteImsakiye writes the timetable as a printable PDF imsakiye with one A4 page per month, headed by the
+// city name and its Qibla angle. On days of Ramadan the row is shaded and the end of sahur (Fajr) and
+// iftar (Maghrib) are emphasized. Text is set in Helvetica; characters outside Windows-1254 are replaced by '?'.
+func (t Timetable) WriteImsakiye(w io.Writer, detail CityDetail, opts ImsakiyeOptions) error {
+	if len(t) == 0 {
+		return errors.New(errorPrefix + "unable to write empty timetable as imsakiye")
+	}
+	if opts.Title == "" {
+		opts.Title = "Imsakiye"
+	}
+	if opts.Color == nil {
+		opts.Color = color.RGBA{0x1B, 0x5E, 0x5A, 0xFF}
+	}
+	if opts.RamadanColor == nil {
+		opts.RamadanColor = color.RGBA{0xE2, 0xEF, 0xDA, 0xFF}
+	}
+
+	var pdf pdfWriter
+	pdf.object("<< /Type /Catalog /Pages 2 0 R >>")
+	pages := pdf.reserve()
+	pdf.object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding 5 0 R >>")
+	pdf.object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding 5 0 R >>")
+	pdf.object("<< /Type /Encoding /BaseEncoding /WinAnsiEncoding" +
+		" /Differences [208 /Gbreve 221 /Idotaccent /Scedilla 240 /gbreve 253 /dotlessi /scedilla] >>")
+	resources := "<< /Font << /F1 3 0 R /F2 4 0 R >>"
+	var logoWidth float64
+	if opts.Logo != nil {
+		bounds := opts.Logo.Bounds()
+		if bounds.Empty() {
+			return errors.New(errorPrefix + "unable to write imsakiye with empty logo")
+		}
+		// Composite the logo onto white, as JPEG has no transparency.
+		rgba := image.NewRGBA(bounds)
+		draw.Draw(rgba, bounds, image.White, image.Point{}, draw.Src)
+		draw.Draw(rgba, bounds, opts.Logo, bounds.Min, draw.Over)
+		var logo bytes.Buffer
+		if err := jpeg.Encode(&logo, rgba, &jpeg.Options{Quality: 90}); err != nil {
+			return fmt.Errorf(errorPrefix+"unable to encode imsakiye logo: %w", err)
+		}
+		id := pdf.stream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d"+
+			" /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /DCTDecode", bounds.Dx(), bounds.Dy()), logo.Bytes())
+		resources += fmt.Sprintf(" /XObject << /Logo %d 0 R >>", id)
+		logoWidth = 60 * float64(bounds.Dx()) / float64(bounds.Dy())
+	}
+	resources += " >>"
+
+	var kids []string
+	for _, month := range t.months() {
+		content := imsakiyePage(month, detail, opts, logoWidth)
+		id := pdf.stream("", content)
+		page := pdf.object(fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Resources %s /Contents %d 0 R >>",
+			pages, imsakiyePageWidth, imsakiyePageHeight, resources, id))
+		kids = append(kids, fmt.Sprintf("%d 0 R", page))
+	}
+	pdf.set(pages, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids)))
+
+	if _, err := w.Write(pdf.bytes()); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write timetable as imsakiye: %w", err)
+	}
+	return nil
+}
+
+// imsakiyePage returns the content stream of the page of a month.
+func imsakiyePage(days Timetable, detail CityDetail, opts ImsakiyeOptions, logoWidth float64) []byte {
+	var p pdfContent
+	top := float64(imsakiyePageHeight - imsakiyeMargin)
+
+	x := float64(imsakiyeMargin)
+	if logoWidth > 0 {
+		p.printf("q %.2f 0 0 60 %d %.2f cm /Logo Do Q\n", logoWidth, imsakiyeMargin, top-60)
+		x += logoWidth + 12
+	}
+	month := days[0].GregorianDate
+	p.text(x, top-20, "F2", 18, opts.Color, opts.Title+" – "+month.Month().String()+" "+month.Format("2006"))
+	place := detail.LocalizedName(opts.Language)
+	if country := detail.LocalizedCountryName(opts.Language); country != "" && country != place {
+		place += ", " + country
+	}
+	p.text(x, top-40, "F2", 12, color.Black, place)
+	if detail.QiblaAngle != "" {
+		p.text(x, top-55, "F1", 10, color.Black, "Qibla angle: "+detail.QiblaAngle+"°")
+	}
+
+	widths := imsakiyeColumnWidths[:]
+	prayerWidth := (imsakiyePageWidth - 2*imsakiyeMargin - widths[0] - widths[1] - widths[2]) / float64(len(PrayerNames))
+	for range PrayerNames {
+		widths = append(widths, prayerWidth)
+	}
+	tableWidth := float64(imsakiyePageWidth - 2*imsakiyeMargin)
+
+	y := top - 80
+	p.rect(imsakiyeMargin, y-imsakiyeRowHeight, tableWidth, imsakiyeRowHeight, opts.Color)
+	headers := []string{"Date", "Day", "Hijri"}
+	for _, name := range PrayerNames {
+		headers = append(headers, name.String())
+	}
+	p.row(y, widths, headers, func(int) (string, color.Color) { return "F2", color.White })
+
+	ramadanDays := false
+	for _, pt := range days {
+		y -= imsakiyeRowHeight
+		ramadanDay := !pt.HijriDate.IsZero() && int(pt.HijriDate.Month()) == ramadan
+		if ramadanDay {
+			ramadanDays = true
+			p.rect(imsakiyeMargin, y-imsakiyeRowHeight, tableWidth, imsakiyeRowHeight, opts.RamadanColor)
+		}
+		values := []string{pt.GregorianDate.Format("02.01.2006"), pt.GregorianDate.Weekday().String(), pt.HijriDateShort}
+		for _, name := range PrayerNames {
+			values = append(values, pt.Clock(name))
+		}
+		p.row(y, widths, values, func(column int) (string, color.Color) {
+			if ramadanDay && (column == 3+int(Fajr) || column == 3+int(Maghrib)) {
+				return "F2", opts.Color
+			}
+			if pt.GregorianDate.Weekday() == time.Friday {
+				return "F2", color.Black
+			}
+			return "F1", color.Black
+		})
+	}
+	y -= imsakiyeRowHeight
+
+	// Grid lines around the header and days.
+	p.printf("0.5 w 0.6 G\n")
+	for line := top - 80; line >= y-0.01; line -= imsakiyeRowHeight {
+		p.printf("%d %.2f m %.2f %.2f l S\n", imsakiyeMargin, line, imsakiyeMargin+tableWidth, line)
+	}
+	column := float64(imsakiyeMargin)
+	for i := 0; i <= len(widths); i++ {
+		p.printf("%.2f %.2f m %.2f %.2f l S\n", column, top-80, column, y)
+		if i < len(widths) {
+			column += widths[i]
+		}
+	}
+
+	if ramadanDays {
+		p.text(imsakiyeMargin, y-15, "F1", 8, color.Black,
+			"Shaded days are in Ramadan: Fajr marks the end of sahur (imsak), Maghrib the iftar.")
+	}
+	p.text(imsakiyeMargin, imsakiyeMargin-15, "F1", 8, color.Gray{0x66}, "Source: Diyanet İşleri Başkanlığı")
+	return p.Bytes()
+}
+
+// pdfWriter assembles a PDF file from numbered objects.
+type pdfWriter struct {
+	objects [][]byte
+}
+
+// object adds an object and returns its number.
+func (pw *pdfWriter) object(s string) int {
+	pw.objects = append(pw.objects, []byte(s))
+	return len(pw.objects)
+}
+
+// reserve returns the number of an object to be set later.
+func (pw *pdfWriter) reserve() int {
+	return pw.object("")
+}
+
+// set sets the content of a reserved object.
+func (pw *pdfWriter) set(id int, s string) {
+	pw.objects[id-1] = []byte(s)
+}
+
+// stream adds a stream object with the given dictionary entries and returns its number.
+func (pw *pdfWriter) stream(dict string, data []byte) int {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "<< %s /Length %d >>\nstream\n", dict, len(data))
+	b.Write(data)
+	b.WriteString("\nendstream")
+	pw.objects = append(pw.objects, b.Bytes())
+	return len(pw.objects)
+}
+
+// bytes returns the PDF file with its cross-reference table.
+func (pw *pdfWriter) bytes() []byte {
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+	offsets := make([]int, len(pw.objects))
+	for i, object := range pw.objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n", i+1)
+		b.Write(object)
+		b.WriteString("\nendobj\n")
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(pw.objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(pw.objects)+1, xref)
+	return b.Bytes()
+}
+
+// pdfContent builds a page content stream.
+type pdfContent struct {
+	bytes.Buffer
+}
+
+func (p *pdfContent) printf(format string, args ...any) {
+	fmt.Fprintf(p, format, args...)
+}
+
+// text sets s at x, y in the font of the page resources, e.g. "F1".
+func (p *pdfContent) text(x, y float64, font string, size float64, c color.Color, s string) {
+	r, g, b := pdfColor(c)
+	p.printf("BT /%s %g Tf %.3f %.3f %.3f rg %.2f %.2f Td (%s) Tj ET\n", font, size, r, g, b, x, y, pdfString(s))
+}
+
+// rect fills a rectangle.
+func (p *pdfContent) rect(x, y, width, height float64, c color.Color) {
+	r, g, b := pdfColor(c)
+	p.printf("%.3f %.3f %.3f rg %.2f %.2f %.2f %.2f re f\n", r, g, b, x, y, width, height)
+}
+
+// row sets the values of a table row whose top is at y, in the font and color returned for each column.
+func (p *pdfContent) row(y float64, widths []float64, values []string, style func(column int) (string, color.Color)) {
+	x := float64(imsakiyeMargin)
+	for i, value := range values {
+		font, c := style(i)
+		p.text(x+4, y-imsakiyeRowHeight+6, font, 9, c, value)
+		x += widths[i]
+	}
+}
+
+// pdfColor returns the RGB components of c between 0 and 1.
+func pdfColor(c color.Color) (r, g, b float64) {
+	cr, cg, cb, _ := c.RGBA()
+	return float64(cr) / 0xFFFF, float64(cg) / 0xFFFF, float64(cb) / 0xFFFF
+}
+
+// pdfString returns s as the content of a PDF string literal in the encoding of the imsakiye fonts.
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		c, ok := imsakiyeEncoding[r]
+		switch {
+		case ok:
+		case r == '–':
+			c = 0x96
+		case r >= 0x20 && r < 0x7F || r >= 0xA0 && r <= 0xFF && !imsakiyeReplaced(byte(r)):
+			c = byte(r)
+		default:
+			c = '?'
+		}
+		if c == '(' || c == ')' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
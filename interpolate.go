@@ -0,0 +1,119 @@
+package diyanet
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// maxInterpolationSources is the number of nearest cities prayer times are interpolated from.
+const maxInterpolationSources = 3
+
+// LocatedTimetable is the timetable of a city at known coordinates.
+// The Diyanet Awqat Salah API does not provide coordinates, so they must be supplied by the caller.
+type LocatedTimetable struct {
+	// Coordinates is the location of the city.
+	Coordinates Coordinates
+	// Timetable holds the prayer times of the city.
+	Timetable Timetable
+}
+
+// InterpolationSource is a city contributing to an interpolated prayer day.
+type InterpolationSource struct {
+	// Coordinates is the location of the city.
+	Coordinates Coordinates
+	// DistanceKm is the distance from the interpolated location in kilometers.
+	DistanceKm float64
+	// Weight is the share of the city in the result; the weights of all sources sum to 1.
+	Weight float64
+}
+
+// ApproximatePrayerDay holds prayer times interpolated for a location that is not a listed city.
+// The times are approximations and must not be presented as official Diyanet prayer times.
+type ApproximatePrayerDay struct {
+	PrayerDay
+	// Sources are the cities the times were interpolated from.
+	Sources []InterpolationSource
+}
+
+// InterpolatePrayerTimes approximates the prayer times at the given coordinates on the given date
+// from the nearest (up to three) cities, weighted by inverse distance.
+// Only cities whose timetable contains the date are considered. The times are returned in the
+// location of the nearest city.
+func InterpolatePrayerTimes(at Coordinates, date time.Time, cities []LocatedTimetable) (ApproximatePrayerDay, error) {
+	type candidate struct {
+		source InterpolationSource
+		day    PrayerDay
+	}
+
+	var candidates []candidate
+	for _, city := range cities {
+		pt, ok := city.Timetable.ByDate(date)
+		if !ok {
+			continue
+		}
+		day, err := pt.Day()
+		if err != nil {
+			return ApproximatePrayerDay{}, err
+		}
+		candidates = append(candidates, candidate{
+			source: InterpolationSource{Coordinates: city.Coordinates, DistanceKm: at.DistanceTo(city.Coordinates)},
+			day:    day,
+		})
+	}
+	if len(candidates) == 0 {
+		return ApproximatePrayerDay{}, fmt.Errorf(errorPrefix+"no city with prayer times for %s to interpolate from",
+			date.Format(time.DateOnly))
+	}
+
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		switch {
+		case a.source.DistanceKm < b.source.DistanceKm:
+			return -1
+		case a.source.DistanceKm > b.source.DistanceKm:
+			return 1
+		}
+		return 0
+	})
+	candidates = candidates[:min(len(candidates), maxInterpolationSources)]
+
+	if candidates[0].source.DistanceKm == 0 {
+		candidates = candidates[:1]
+	}
+	var total float64
+	for i := range candidates {
+		if candidates[i].source.DistanceKm == 0 {
+			candidates[i].source.Weight = 1
+		} else {
+			candidates[i].source.Weight = 1 / candidates[i].source.DistanceKm
+		}
+		total += candidates[i].source.Weight
+	}
+
+	nearest := candidates[0].day
+	result := ApproximatePrayerDay{
+		PrayerDay: PrayerDay{
+			Date:  nearest.Date,
+			Times: make(map[PrayerName]time.Time, len(PrayerNames)),
+		},
+	}
+	loc := nearest.Date.Location()
+	for _, name := range PrayerNames {
+		var offset float64
+		for _, c := range candidates {
+			t, ok := c.day.Times[name]
+			if !ok {
+				return ApproximatePrayerDay{}, errors.New(errorPrefix + "incomplete prayer times to interpolate from")
+			}
+			offset += float64(t.Sub(nearest.Times[name])) * c.source.Weight / total
+		}
+		result.Times[name] = nearest.Times[name].Add(time.Duration(offset)).In(loc)
+	}
+	for _, c := range candidates {
+		c.source.Weight /= total
+		result.Sources = append(result.Sources, c.source)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,21 @@
+package diyanet
+
+import "time"
+
+// Interval is a half-open time window [Start, End).
+type Interval struct {
+	// Start is the first instant of the window.
+	Start time.Time
+	// End is the first instant after the window.
+	End time.Time
+}
+
+// Contains reports whether t lies within the interval.
+func (i Interval) Contains(t time.Time) bool {
+	return !t.Before(i.Start) && t.Before(i.End)
+}
+
+// Duration returns the length of the interval.
+func (i Interval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
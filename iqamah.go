@@ -0,0 +1,101 @@
+package diyanet
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IqamahRule determines the congregation (iqamah/jamaah) time of a prayer,
+// either as a fixed clock time or as an offset from the adhan.
+type IqamahRule struct {
+	// Clock is a fixed "HH:MM" congregation time. If set, Offset is ignored.
+	// A fixed time earlier than the adhan is moved to the adhan.
+	Clock string
+	// Offset is the delay of the congregation after the adhan.
+	Offset time.Duration
+}
+
+// IqamahConfig configures the congregation times per prayer.
+// Prayers without a rule, typically Sunrise, have no congregation time.
+type IqamahConfig map[PrayerName]IqamahRule
+
+// Apply sets the congregation times of day according to the configuration.
+func (c IqamahConfig) Apply(day *PrayerDay) error {
+	if day.Iqamah == nil {
+		day.Iqamah = make(map[PrayerName]time.Time, len(c))
+	}
+
+	for name, rule := range c {
+		adhan, ok := day.Times[name]
+		if !ok {
+			return fmt.Errorf(errorPrefix+"no %s time on %s to derive the iqamah time from",
+				name, day.Date.Format(time.DateOnly))
+		}
+
+		iqamah, err := rule.time(adhan)
+		if err != nil {
+			return fmt.Errorf(errorPrefix+"invalid %s iqamah time: %w", name, err)
+		}
+		day.Iqamah[name] = iqamah
+	}
+
+	return nil
+}
+
+// time returns the congregation time of the prayer beginning at adhan.
+func (r IqamahRule) time(adhan time.Time) (time.Time, error) {
+	if r.Clock == "" {
+		return adhan.Add(r.Offset), nil
+	}
+
+	iqamah, err := parseClock(adhan, r.Clock)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return later(iqamah, adhan), nil
+}
+
+// Column returns the timetable column of the congregation time of the prayer as "HH:MM", headed by its
+// lower-case name and "_iqamah", for exports such as [Timetable.WriteCSV]. Days whose prayer time is invalid
+// and prayers without a rule have an empty value.
+func (c IqamahConfig) Column(name PrayerName) TimetableColumn {
+	return TimetableColumn{
+		Header: strings.ToLower(name.String()) + "_iqamah",
+		Value: func(pt PrayerTime) string {
+			rule, ok := c[name]
+			if !ok {
+				return ""
+			}
+			adhan, err := pt.At(name)
+			if err != nil {
+				return ""
+			}
+			iqamah, err := rule.time(adhan)
+			if err != nil {
+				return ""
+			}
+			return iqamah.Format("15:04")
+		},
+	}
+}
+
+// Day returns the prayer times of pt together with the configured congregation times.
+func (c IqamahConfig) Day(pt PrayerTime) (PrayerDay, error) {
+	day, err := pt.Day()
+	if err != nil {
+		return PrayerDay{}, err
+	}
+	if err := c.Apply(&day); err != nil {
+		return PrayerDay{}, err
+	}
+
+	return day, nil
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
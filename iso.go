@@ -0,0 +1,194 @@
+package diyanet
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ISOCountry holds the ISO 3166-1 codes and the English name of a country.
+type ISOCountry struct {
+	// Alpha2 is the ISO 3166-1 alpha-2 code, e.g. "DE".
+	Alpha2 string
+	// Alpha3 is the ISO 3166-1 alpha-3 code, e.g. "DEU".
+	Alpha3 string
+	// EnglishName is the English short name of the country, e.g. "Germany".
+	EnglishName string
+}
+
+// ISO returns the ISO 3166-1 codes of the country, matched by its Turkish or English name.
+func (c Country) ISO() (ISOCountry, bool) {
+	for _, name := range []string{c.Name, c.Code} {
+		if iso, ok := isoCountriesByName()[NormalizePlaceName(name)]; ok {
+			return iso, true
+		}
+	}
+	return ISOCountry{}, false
+}
+
+// CountryByISO retrieves the country with the given ISO 3166-1 alpha-2 or alpha-3 code
+// (e.g. "DE" or "DEU") from the Diyanet Awqat Salah API.
+func (c Client) CountryByISO(code string) (Country, error) {
+	countries, err := c.GetCountries()
+	if err != nil {
+		return Country{}, err
+	}
+
+	code = strings.ToUpper(code)
+	for _, country := range countries {
+		if iso, ok := country.ISO(); ok && (iso.Alpha2 == code || iso.Alpha3 == code) {
+			return country, nil
+		}
+	}
+
+	return Country{}, fmt.Errorf(errorPrefix+"country with ISO code %s not found", code)
+}
+
+// isoCountriesByName indexes isoCountries by every normalized Turkish and English name.
+var isoCountriesByName = sync.OnceValue(func() map[string]ISOCountry {
+	index := make(map[string]ISOCountry)
+	for _, entry := range isoCountries {
+		for _, name := range append(entry.names, entry.iso.EnglishName) {
+			index[NormalizePlaceName(name)] = entry.iso
+		}
+	}
+	return index
+})
+
+// isoCountries maps the country names used by the Diyanet Awqat Salah API, which are Turkish,
+// to their ISO 3166-1 codes. Spelling variants only differing in diacritics need not be listed.
+var isoCountries = []struct {
+	names []string
+	iso   ISOCountry
+}{
+	{[]string{"Türkiye", "Turkiye"}, ISOCountry{"TR", "TUR", "Türkiye"}},
+	{[]string{"Almanya"}, ISOCountry{"DE", "DEU", "Germany"}},
+	{[]string{"Avusturya"}, ISOCountry{"AT", "AUT", "Austria"}},
+	{[]string{"Belçika"}, ISOCountry{"BE", "BEL", "Belgium"}},
+	{[]string{"Hollanda"}, ISOCountry{"NL", "NLD", "Netherlands"}},
+	{[]string{"Fransa"}, ISOCountry{"FR", "FRA", "France"}},
+	{[]string{"İngiltere", "Birleşik Krallık"}, ISOCountry{"GB", "GBR", "United Kingdom"}},
+	{[]string{"İsviçre"}, ISOCountry{"CH", "CHE", "Switzerland"}},
+	{[]string{"Danimarka"}, ISOCountry{"DK", "DNK", "Denmark"}},
+	{[]string{"İsveç"}, ISOCountry{"SE", "SWE", "Sweden"}},
+	{[]string{"Norveç"}, ISOCountry{"NO", "NOR", "Norway"}},
+	{[]string{"Finlandiya"}, ISOCountry{"FI", "FIN", "Finland"}},
+	{[]string{"İzlanda"}, ISOCountry{"IS", "ISL", "Iceland"}},
+	{[]string{"İtalya"}, ISOCountry{"IT", "ITA", "Italy"}},
+	{[]string{"İspanya"}, ISOCountry{"ES", "ESP", "Spain"}},
+	{[]string{"Portekiz"}, ISOCountry{"PT", "PRT", "Portugal"}},
+	{[]string{"İrlanda"}, ISOCountry{"IE", "IRL", "Ireland"}},
+	{[]string{"Lüksemburg"}, ISOCountry{"LU", "LUX", "Luxembourg"}},
+	{[]string{"Lihtenştayn"}, ISOCountry{"LI", "LIE", "Liechtenstein"}},
+	{[]string{"Monako"}, ISOCountry{"MC", "MCO", "Monaco"}},
+	{[]string{"Malta"}, ISOCountry{"MT", "MLT", "Malta"}},
+	{[]string{"Polonya"}, ISOCountry{"PL", "POL", "Poland"}},
+	{[]string{"Çekya", "Çek Cumhuriyeti"}, ISOCountry{"CZ", "CZE", "Czechia"}},
+	{[]string{"Slovakya"}, ISOCountry{"SK", "SVK", "Slovakia"}},
+	{[]string{"Macaristan"}, ISOCountry{"HU", "HUN", "Hungary"}},
+	{[]string{"Romanya"}, ISOCountry{"RO", "ROU", "Romania"}},
+	{[]string{"Moldova"}, ISOCountry{"MD", "MDA", "Moldova"}},
+	{[]string{"Bulgaristan"}, ISOCountry{"BG", "BGR", "Bulgaria"}},
+	{[]string{"Yunanistan"}, ISOCountry{"GR", "GRC", "Greece"}},
+	{[]string{"Bosna Hersek", "Bosna-Hersek"}, ISOCountry{"BA", "BIH", "Bosnia and Herzegovina"}},
+	{[]string{"Kuzey Makedonya", "Makedonya"}, ISOCountry{"MK", "MKD", "North Macedonia"}},
+	{[]string{"Kosova"}, ISOCountry{"XK", "XKX", "Kosovo"}},
+	{[]string{"Arnavutluk"}, ISOCountry{"AL", "ALB", "Albania"}},
+	{[]string{"Sırbistan"}, ISOCountry{"RS", "SRB", "Serbia"}},
+	{[]string{"Karadağ"}, ISOCountry{"ME", "MNE", "Montenegro"}},
+	{[]string{"Hırvatistan"}, ISOCountry{"HR", "HRV", "Croatia"}},
+	{[]string{"Slovenya"}, ISOCountry{"SI", "SVN", "Slovenia"}},
+	{[]string{"Ukrayna"}, ISOCountry{"UA", "UKR", "Ukraine"}},
+	{[]string{"Belarus", "Beyaz Rusya"}, ISOCountry{"BY", "BLR", "Belarus"}},
+	{[]string{"Rusya", "Rusya Federasyonu"}, ISOCountry{"RU", "RUS", "Russia"}},
+	{[]string{"Litvanya"}, ISOCountry{"LT", "LTU", "Lithuania"}},
+	{[]string{"Letonya"}, ISOCountry{"LV", "LVA", "Latvia"}},
+	{[]string{"Estonya"}, ISOCountry{"EE", "EST", "Estonia"}},
+	{[]string{"Gürcistan"}, ISOCountry{"GE", "GEO", "Georgia"}},
+	{[]string{"Azerbaycan"}, ISOCountry{"AZ", "AZE", "Azerbaijan"}},
+	{[]string{"Ermenistan"}, ISOCountry{"AM", "ARM", "Armenia"}},
+	{[]string{"Kıbrıs", "KKTC", "Kuzey Kıbrıs Türk Cumhuriyeti"}, ISOCountry{"CY", "CYP", "Cyprus"}},
+	{[]string{"Kazakistan"}, ISOCountry{"KZ", "KAZ", "Kazakhstan"}},
+	{[]string{"Kırgızistan"}, ISOCountry{"KG", "KGZ", "Kyrgyzstan"}},
+	{[]string{"Özbekistan"}, ISOCountry{"UZ", "UZB", "Uzbekistan"}},
+	{[]string{"Türkmenistan"}, ISOCountry{"TM", "TKM", "Turkmenistan"}},
+	{[]string{"Tacikistan"}, ISOCountry{"TJ", "TJK", "Tajikistan"}},
+	{[]string{"Afganistan"}, ISOCountry{"AF", "AFG", "Afghanistan"}},
+	{[]string{"Pakistan"}, ISOCountry{"PK", "PAK", "Pakistan"}},
+	{[]string{"Hindistan"}, ISOCountry{"IN", "IND", "India"}},
+	{[]string{"Bangladeş"}, ISOCountry{"BD", "BGD", "Bangladesh"}},
+	{[]string{"Sri Lanka"}, ISOCountry{"LK", "LKA", "Sri Lanka"}},
+	{[]string{"Nepal"}, ISOCountry{"NP", "NPL", "Nepal"}},
+	{[]string{"Maldivler"}, ISOCountry{"MV", "MDV", "Maldives"}},
+	{[]string{"İran"}, ISOCountry{"IR", "IRN", "Iran"}},
+	{[]string{"Irak"}, ISOCountry{"IQ", "IRQ", "Iraq"}},
+	{[]string{"Suriye"}, ISOCountry{"SY", "SYR", "Syria"}},
+	{[]string{"Lübnan"}, ISOCountry{"LB", "LBN", "Lebanon"}},
+	{[]string{"Ürdün"}, ISOCountry{"JO", "JOR", "Jordan"}},
+	{[]string{"Filistin"}, ISOCountry{"PS", "PSE", "Palestine"}},
+	{[]string{"İsrail"}, ISOCountry{"IL", "ISR", "Israel"}},
+	{[]string{"Suudi Arabistan"}, ISOCountry{"SA", "SAU", "Saudi Arabia"}},
+	{[]string{"Yemen"}, ISOCountry{"YE", "YEM", "Yemen"}},
+	{[]string{"Umman"}, ISOCountry{"OM", "OMN", "Oman"}},
+	{[]string{"Birleşik Arap Emirlikleri", "BAE"}, ISOCountry{"AE", "ARE", "United Arab Emirates"}},
+	{[]string{"Katar"}, ISOCountry{"QA", "QAT", "Qatar"}},
+	{[]string{"Bahreyn"}, ISOCountry{"BH", "BHR", "Bahrain"}},
+	{[]string{"Kuveyt"}, ISOCountry{"KW", "KWT", "Kuwait"}},
+	{[]string{"Mısır"}, ISOCountry{"EG", "EGY", "Egypt"}},
+	{[]string{"Libya"}, ISOCountry{"LY", "LBY", "Libya"}},
+	{[]string{"Tunus"}, ISOCountry{"TN", "TUN", "Tunisia"}},
+	{[]string{"Cezayir"}, ISOCountry{"DZ", "DZA", "Algeria"}},
+	{[]string{"Fas"}, ISOCountry{"MA", "MAR", "Morocco"}},
+	{[]string{"Moritanya"}, ISOCountry{"MR", "MRT", "Mauritania"}},
+	{[]string{"Sudan"}, ISOCountry{"SD", "SDN", "Sudan"}},
+	{[]string{"Etiyopya"}, ISOCountry{"ET", "ETH", "Ethiopia"}},
+	{[]string{"Somali"}, ISOCountry{"SO", "SOM", "Somalia"}},
+	{[]string{"Cibuti"}, ISOCountry{"DJ", "DJI", "Djibouti"}},
+	{[]string{"Kenya"}, ISOCountry{"KE", "KEN", "Kenya"}},
+	{[]string{"Tanzanya"}, ISOCountry{"TZ", "TZA", "Tanzania"}},
+	{[]string{"Uganda"}, ISOCountry{"UG", "UGA", "Uganda"}},
+	{[]string{"Nijerya"}, ISOCountry{"NG", "NGA", "Nigeria"}},
+	{[]string{"Nijer"}, ISOCountry{"NE", "NER", "Niger"}},
+	{[]string{"Çad"}, ISOCountry{"TD", "TCD", "Chad"}},
+	{[]string{"Mali"}, ISOCountry{"ML", "MLI", "Mali"}},
+	{[]string{"Senegal"}, ISOCountry{"SN", "SEN", "Senegal"}},
+	{[]string{"Gambiya"}, ISOCountry{"GM", "GMB", "Gambia"}},
+	{[]string{"Gine"}, ISOCountry{"GN", "GIN", "Guinea"}},
+	{[]string{"Fildişi Sahili"}, ISOCountry{"CI", "CIV", "Côte d'Ivoire"}},
+	{[]string{"Gana"}, ISOCountry{"GH", "GHA", "Ghana"}},
+	{[]string{"Burkina Faso"}, ISOCountry{"BF", "BFA", "Burkina Faso"}},
+	{[]string{"Kamerun"}, ISOCountry{"CM", "CMR", "Cameroon"}},
+	{[]string{"Güney Afrika", "Güney Afrika Cumhuriyeti"}, ISOCountry{"ZA", "ZAF", "South Africa"}},
+	{[]string{"Mozambik"}, ISOCountry{"MZ", "MOZ", "Mozambique"}},
+	{[]string{"Madagaskar"}, ISOCountry{"MG", "MDG", "Madagascar"}},
+	{[]string{"Morityus"}, ISOCountry{"MU", "MUS", "Mauritius"}},
+	{[]string{"Komorlar"}, ISOCountry{"KM", "COM", "Comoros"}},
+	{[]string{"Çin", "Çin Halk Cumhuriyeti"}, ISOCountry{"CN", "CHN", "China"}},
+	{[]string{"Japonya"}, ISOCountry{"JP", "JPN", "Japan"}},
+	{[]string{"Güney Kore"}, ISOCountry{"KR", "KOR", "South Korea"}},
+	{[]string{"Moğolistan"}, ISOCountry{"MN", "MNG", "Mongolia"}},
+	{[]string{"Endonezya"}, ISOCountry{"ID", "IDN", "Indonesia"}},
+	{[]string{"Malezya"}, ISOCountry{"MY", "MYS", "Malaysia"}},
+	{[]string{"Singapur"}, ISOCountry{"SG", "SGP", "Singapore"}},
+	{[]string{"Brunei"}, ISOCountry{"BN", "BRN", "Brunei"}},
+	{[]string{"Tayland"}, ISOCountry{"TH", "THA", "Thailand"}},
+	{[]string{"Filipinler"}, ISOCountry{"PH", "PHL", "Philippines"}},
+	{[]string{"Vietnam"}, ISOCountry{"VN", "VNM", "Vietnam"}},
+	{[]string{"Myanmar"}, ISOCountry{"MM", "MMR", "Myanmar"}},
+	{[]string{"Avustralya"}, ISOCountry{"AU", "AUS", "Australia"}},
+	{[]string{"Yeni Zelanda"}, ISOCountry{"NZ", "NZL", "New Zealand"}},
+	{[]string{"Amerika Birleşik Devletleri", "ABD"}, ISOCountry{"US", "USA", "United States"}},
+	{[]string{"Kanada"}, ISOCountry{"CA", "CAN", "Canada"}},
+	{[]string{"Meksika"}, ISOCountry{"MX", "MEX", "Mexico"}},
+	{[]string{"Brezilya"}, ISOCountry{"BR", "BRA", "Brazil"}},
+	{[]string{"Arjantin"}, ISOCountry{"AR", "ARG", "Argentina"}},
+	{[]string{"Şili"}, ISOCountry{"CL", "CHL", "Chile"}},
+	{[]string{"Kolombiya"}, ISOCountry{"CO", "COL", "Colombia"}},
+	{[]string{"Venezuela"}, ISOCountry{"VE", "VEN", "Venezuela"}},
+	{[]string{"Peru"}, ISOCountry{"PE", "PER", "Peru"}},
+	{[]string{"Ekvador"}, ISOCountry{"EC", "ECU", "Ecuador"}},
+	{[]string{"Küba"}, ISOCountry{"CU", "CUB", "Cuba"}},
+	{[]string{"Surinam"}, ISOCountry{"SR", "SUR", "Suriname"}},
+	{[]string{"Guyana"}, ISOCountry{"GY", "GUY", "Guyana"}},
+	{[]string{"Trinidad ve Tobago"}, ISOCountry{"TT", "TTO", "Trinidad and Tobago"}},
+}
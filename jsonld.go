@@ -0,0 +1,103 @@
+package diyanet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// JSONLDOptions configures [Timetable.WriteJSONLD].
+type JSONLDOptions struct {
+	// Location is the name of the place the prayers are held at, e.g. the mosque or the city.
+	Location string
+	// Address is the postal address of the location; search engines require it for event results.
+	Address string
+	// URL is the URL of the page with the prayer times. If set, events get identifiers derived from it.
+	URL string
+	// Prayers selects the prayers written as events. If empty, all prayers except Sunrise are written.
+	Prayers []PrayerName
+}
+
+// jsonLDEvent is a schema.org Event.
+type jsonLDEvent struct {
+	Type                string       `json:"@type"`
+	ID                  string       `json:"@id,omitempty"`
+	Name                string       `json:"name"`
+	StartDate           string       `json:"startDate"`
+	EventStatus         string       `json:"eventStatus"`
+	EventAttendanceMode string       `json:"eventAttendanceMode"`
+	IsAccessibleForFree bool         `json:"isAccessibleForFree"`
+	Location            *jsonLDPlace `json:"location,omitempty"`
+	URL                 string       `json:"url,omitempty"`
+}
+
+// jsonLDPlace is a schema.org Place.
+type jsonLDPlace struct {
+	Type    string `json:"@type"`
+	Name    string `json:"name"`
+	Address string `json:"address,omitempty"`
+}
+
+// WriteJSONLD writes the prayer times as schema.org Event markup in JSON-LD, one event per prayer, for
+// search engines to show the prayers of a mosque website. Embed the output in the page in a
+// <script type="application/ld+json"> element; [WidgetOptions.StructuredData] does so for the widget.
+func (t Timetable) WriteJSONLD(w io.Writer, opts JSONLDOptions) error {
+	data, err := t.jsonLD(opts)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write JSON-LD: %w", err)
+	}
+	return nil
+}
+
+// jsonLD returns the JSON-LD document of the prayer times. It contains no "<" and ">", so it can be embedded
+// in a script element.
+func (t Timetable) jsonLD(opts JSONLDOptions) ([]byte, error) {
+	prayers, err := sortedPrayers(t)
+	if err != nil {
+		return nil, err
+	}
+
+	var place *jsonLDPlace
+	if opts.Location != "" {
+		place = &jsonLDPlace{Type: "Place", Name: opts.Location, Address: opts.Address}
+	}
+	events := []jsonLDEvent{}
+	for _, prayer := range prayers {
+		if len(opts.Prayers) > 0 && !slices.Contains(opts.Prayers, prayer.Name) ||
+			len(opts.Prayers) == 0 && prayer.Name == Sunrise {
+			continue
+		}
+		event := jsonLDEvent{
+			Type:                "Event",
+			Name:                prayer.Name.String() + " prayer",
+			StartDate:           prayer.Time.Format(time.RFC3339),
+			EventStatus:         "https://schema.org/EventScheduled",
+			EventAttendanceMode: "https://schema.org/OfflineEventAttendanceMode",
+			IsAccessibleForFree: true,
+			Location:            place,
+			URL:                 opts.URL,
+		}
+		if opts.Location != "" {
+			event.Name += " – " + opts.Location
+		}
+		if opts.URL != "" {
+			event.ID = opts.URL + "#" + prayer.Time.Format("2006-01-02") + "-" + strings.ToLower(prayer.Name.String())
+		}
+		events = append(events, event)
+	}
+
+	data, err := json.Marshal(struct {
+		Context string        `json:"@context"`
+		Graph   []jsonLDEvent `json:"@graph"`
+	}{"https://schema.org", events})
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to encode JSON-LD: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,50 @@
+package diyanet
+
+import "time"
+
+// KarahatMargins configures the length of the disliked (makruh) windows during which voluntary prayers are not performed.
+type KarahatMargins struct {
+	// Sunrise is the length of the window starting at sunrise.
+	Sunrise time.Duration
+	// Zawal is the length of the window ending at Dhuhr, while the sun is at its zenith (istiwa).
+	Zawal time.Duration
+	// Sunset is the length of the window ending at Maghrib, while the sun is setting.
+	Sunset time.Duration
+}
+
+// DefaultKarahatMargins are commonly used margins for the makruh windows.
+var DefaultKarahatMargins = KarahatMargins{
+	Sunrise: 45 * time.Minute,
+	Zawal:   15 * time.Minute,
+	Sunset:  45 * time.Minute,
+}
+
+// KarahatWindows holds the three makruh windows of a day.
+type KarahatWindows struct {
+	// Sunrise is the window after the sun has risen.
+	Sunrise Interval
+	// Zawal is the window before Dhuhr.
+	Zawal Interval
+	// Sunset is the window before the sun has set.
+	Sunset Interval
+}
+
+// Contains reports whether t lies within any of the windows.
+func (w KarahatWindows) Contains(t time.Time) bool {
+	return w.Sunrise.Contains(t) || w.Zawal.Contains(t) || w.Sunset.Contains(t)
+}
+
+// KarahatWindows derives the makruh windows of the day from Sunrise, Dhuhr and Maghrib using the given margins.
+func (pt PrayerTime) KarahatWindows(margins KarahatMargins) (KarahatWindows, error) {
+	day, err := pt.Day()
+	if err != nil {
+		return KarahatWindows{}, err
+	}
+
+	sunrise, dhuhr, maghrib := day.Times[Sunrise], day.Times[Dhuhr], day.Times[Maghrib]
+	return KarahatWindows{
+		Sunrise: Interval{Start: sunrise, End: sunrise.Add(margins.Sunrise)},
+		Zawal:   Interval{Start: dhuhr.Add(-margins.Zawal), End: dhuhr},
+		Sunset:  Interval{Start: maghrib.Add(-margins.Sunset), End: maghrib},
+	}, nil
+}
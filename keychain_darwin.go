@@ -0,0 +1,15 @@
+package diyanet
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+)
+
+func readKeychain(ctx context.Context, service, account string) (string, error) {
+	out, err := exec.CommandContext(ctx, "security", "find-generic-password", "-s", service, "-a", account, "-w").Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
@@ -0,0 +1,12 @@
+//go:build !unix && !windows
+
+package diyanet
+
+import (
+	"context"
+	"errors"
+)
+
+func readKeychain(context.Context, string, string) (string, error) {
+	return "", errors.New("no supported credential store on this platform")
+}
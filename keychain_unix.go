@@ -0,0 +1,17 @@
+//go:build unix && !darwin
+
+package diyanet
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+)
+
+func readKeychain(ctx context.Context, service, account string) (string, error) {
+	out, err := exec.CommandContext(ctx, "secret-tool", "lookup", "service", service, "account", account).Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
@@ -0,0 +1,67 @@
+package diyanet
+
+import (
+	"bytes"
+	"context"
+	"syscall"
+	"unicode/utf16"
+	"unicode/utf8"
+	"unsafe"
+)
+
+var (
+	advapi32     = syscall.NewLazyDLL("advapi32.dll")
+	procCredRead = advapi32.NewProc("CredReadW")
+	procCredFree = advapi32.NewProc("CredFree")
+)
+
+const credTypeGeneric = 1
+
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+func readKeychain(_ context.Context, service, _ string) (string, error) {
+	target, err := syscall.UTF16PtrFromString(service)
+	if err != nil {
+		return "", err
+	}
+
+	var cred *credential
+	ret, _, err := procCredRead.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0, uintptr(unsafe.Pointer(&cred)))
+	if ret == 0 {
+		return "", err
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+
+	return decodeCredentialBlob(unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)), nil
+}
+
+// decodeCredentialBlob decodes a credential blob. Credential Manager and cmdkey store passwords as UTF-16LE,
+// while other tools may store the bytes of a UTF-8 string. UTF-8 text contains no NUL bytes, whereas UTF-16LE
+// text has NUL high bytes for every ASCII character; blobs without NUL bytes are taken as UTF-16LE only if they
+// are not valid UTF-8. A trailing NUL terminator is dropped.
+func decodeCredentialBlob(blob []byte) string {
+	if !bytes.Contains(blob, []byte{0}) && (utf8.Valid(blob) || len(blob)%2 != 0) {
+		return string(blob)
+	}
+	chars := make([]uint16, len(blob)/2)
+	for i := range chars {
+		chars[i] = uint16(blob[2*i]) | uint16(blob[2*i+1])<<8
+	}
+	for len(chars) > 0 && chars[len(chars)-1] == 0 {
+		chars = chars[:len(chars)-1]
+	}
+	return string(utf16.Decode(chars))
+}
@@ -0,0 +1,87 @@
+package diyanet
+
+// Language selects the language of place names and content, as an IETF language tag such as "tr" or "en".
+type Language string
+
+const (
+	// Turkish selects the names used by the Diyanet Awqat Salah API, which are Turkish.
+	Turkish Language = "tr"
+	// English selects English names where they are known.
+	English Language = "en"
+)
+
+// LocalizedName returns the name of the country in the given language. English names are taken from the
+// ISO 3166 table; the Turkish name is returned for unknown countries and languages.
+func (c Country) LocalizedName(lang Language) string {
+	if lang == English {
+		if iso, ok := c.ISO(); ok {
+			return iso.EnglishName
+		}
+	}
+	return c.Name
+}
+
+// LocalizedName returns the name of the state in the given language.
+// The Diyanet Awqat Salah API provides no translated state names, so this is always the name as returned by the API.
+func (s State) LocalizedName(lang Language) string {
+	return s.Name
+}
+
+// LocalizedName returns the name of the city in the given language. English names are retrieved
+// from the city detail endpoint; the Turkish name is returned if no English name is available.
+func (c City) LocalizedName(lang Language) (string, error) {
+	if lang != English {
+		return c.Name, nil
+	}
+
+	detail, err := c.GetCityDetail()
+	if err != nil {
+		return "", err
+	}
+	return detail.LocalizedName(lang), nil
+}
+
+// LocalizedName returns the name of the city in the given language.
+func (d CityDetail) LocalizedName(lang Language) string {
+	if lang == English && d.CityEn != "" {
+		return d.CityEn
+	}
+	if d.City != "" {
+		return d.City
+	}
+	return d.Name
+}
+
+// LocalizedCountryName returns the name of the country of the city in the given language.
+func (d CityDetail) LocalizedCountryName(lang Language) string {
+	if lang == English && d.CountryEn != "" {
+		return d.CountryEn
+	}
+	return d.Country
+}
+
+// LocalizedCityNames retrieves the names of all cities in the tree in the given language, keyed by city ID.
+// Names that cannot be retrieved fall back to the name as returned by the API and their errors are returned
+// keyed by city ID.
+func (t *PlaceTree) LocalizedCityNames(lang Language) (map[int]string, map[int]error) {
+	names := make(map[int]string, len(t.cities))
+	for id, node := range t.cities {
+		names[id] = node.City.Name
+	}
+	if lang != English || len(t.cities) == 0 {
+		return names, nil
+	}
+
+	var client Client
+	ids := make([]int, 0, len(t.cities))
+	for id, node := range t.cities {
+		client = node.City.client
+		ids = append(ids, id)
+	}
+
+	details, errs := client.GetCityDetails(ids)
+	for id, detail := range details {
+		names[id] = detail.LocalizedName(lang)
+	}
+	return names, errs
+}
@@ -0,0 +1,79 @@
+package diyanet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// matrixTransactions numbers the messages sent by a [MatrixNotifier], for unique transaction IDs.
+var matrixTransactions atomic.Int64
+
+// MatrixNotifier posts notifications into Matrix rooms, see https://spec.matrix.org/latest/client-server-api/.
+//
+// Use the access token of a dedicated bot account that has joined the rooms.
+type MatrixNotifier struct {
+	// HomeserverURL is the URL of the homeserver of the bot account, e.g. "https://matrix.example.org/".
+	HomeserverURL string
+	// AccessToken is the access token of the bot account.
+	AccessToken string
+	// Rooms are the rooms notifications are posted to, each with its own preferences.
+	Rooms []MatrixRoom
+	// Notice sends the messages as notices, which clients show less prominently and bots do not answer.
+	Notice bool
+	// HTTPClient is the HTTP client used to make requests. Defaults to [http.DefaultClient].
+	HTTPClient *http.Client
+}
+
+// MatrixRoom is a room a [MatrixNotifier] posts to.
+type MatrixRoom struct {
+	// RoomID is the ID of the room, e.g. "!abcdefg:example.org".
+	RoomID string
+	// Filter selects the notifications posted to the room.
+	Filter NotificationFilter
+}
+
+// Send posts the notification, formatted with a bold title, to every room whose filter selects it.
+// Failed rooms do not prevent posting to the others; their errors are returned joined.
+func (m MatrixNotifier) Send(ctx context.Context, n Notification) error {
+	if m.HomeserverURL == "" || m.AccessToken == "" {
+		return errors.New(errorPrefix + "matrix notifier without homeserver or access token")
+	}
+
+	msgtype := "m.text"
+	if m.Notice {
+		msgtype = "m.notice"
+	}
+	body, formatted := n.Title, "<b>"+html.EscapeString(n.Title)+"</b>"
+	if n.Text != "" {
+		body += "\n\n" + n.Text
+		formatted += "<br><br>" + strings.ReplaceAll(html.EscapeString(n.Text), "\n", "<br>")
+	}
+	payload := map[string]any{
+		"msgtype":        msgtype,
+		"body":           body,
+		"format":         "org.matrix.custom.html",
+		"formatted_body": formatted,
+	}
+	header := http.Header{"Authorization": {"Bearer " + m.AccessToken}}
+
+	var errs []error
+	for _, room := range m.Rooms {
+		if !room.Filter.Matches(n) {
+			continue
+		}
+		txnID := fmt.Sprintf("diyanet-%d-%d", time.Now().UnixNano(), matrixTransactions.Add(1))
+		endpoint := strings.TrimSuffix(m.HomeserverURL, "/") + "/_matrix/client/v3/rooms/" +
+			url.PathEscape(room.RoomID) + "/send/m.room.message/" + txnID
+		if err := sendJSON(ctx, m.HTTPClient, "PUT", "matrix", endpoint, header, payload, nil); err != nil {
+			errs = append(errs, fmt.Errorf("%w (room %s)", err, room.RoomID))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,34 @@
+package diyanet
+
+// Names of the counters emitted through [Metrics].
+const (
+	// MetricAuthLogins counts logins with email and password.
+	MetricAuthLogins = "diyanet_auth_logins_total"
+	// MetricAuthRefreshes counts access token refreshes using a refresh token.
+	MetricAuthRefreshes = "diyanet_auth_refreshes_total"
+	// MetricAuthRefreshFallbacks counts failed token refreshes that fell back to a full login.
+	MetricAuthRefreshFallbacks = "diyanet_auth_refresh_fallbacks_total"
+	// MetricAuthFailures counts token retrievals that failed altogether.
+	MetricAuthFailures = "diyanet_auth_failures_total"
+)
+
+// Metrics receives counters emitted by the client, e.g. to forward them to a monitoring system.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// IncCounter increments the counter with the given name by one.
+	IncCounter(name string)
+}
+
+// MetricsFunc is an adapter to allow the use of ordinary functions as [Metrics].
+type MetricsFunc func(name string)
+
+// IncCounter implements [Metrics].
+func (f MetricsFunc) IncCounter(name string) {
+	f(name)
+}
+
+func (c Config) incCounter(name string) {
+	if c.Metrics != nil {
+		c.Metrics.IncCounter(name)
+	}
+}
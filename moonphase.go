@@ -0,0 +1,97 @@
+package diyanet
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// MoonPhase is the phase of the moon on a given day.
+type MoonPhase int
+
+// The phases of the moon in order of the lunar month.
+const (
+	MoonPhaseUnknown MoonPhase = iota
+	NewMoon
+	WaxingCrescent
+	FirstQuarter
+	WaxingGibbous
+	FullMoon
+	WaningGibbous
+	LastQuarter
+	WaningCrescent
+)
+
+var moonPhaseStrings = [...]string{
+	"unknown",
+	"new moon",
+	"waxing crescent",
+	"first quarter",
+	"waxing gibbous",
+	"full moon",
+	"waning gibbous",
+	"last quarter",
+	"waning crescent",
+}
+
+// String returns a human-readable name of the phase, e.g. "waxing crescent".
+func (p MoonPhase) String() string {
+	if p < 0 || int(p) >= len(moonPhaseStrings) {
+		return fmt.Sprintf("MoonPhase(%d)", int(p))
+	}
+	return moonPhaseStrings[p]
+}
+
+// MoonPhase returns the phase of the moon on the day.
+//
+// The image referenced by ShapeMoonURL is named after the day of the lunar month (e.g. ".../r14.png"),
+// from which the phase is derived. If the URL carries no such number, the day of the Hijri date is used instead.
+func (pt PrayerTime) MoonPhase() MoonPhase {
+	if day, ok := lunarDayFromURL(pt.ShapeMoonURL); ok {
+		return moonPhaseOfLunarDay(day)
+	}
+	if !pt.HijriDate.IsZero() {
+		return moonPhaseOfLunarDay(pt.HijriDate.Day())
+	}
+	return MoonPhaseUnknown
+}
+
+// lunarDayFromURL extracts the trailing number of the image file name.
+func lunarDayFromURL(url string) (int, bool) {
+	name := path.Base(url)
+	name = strings.TrimSuffix(name, path.Ext(name))
+	digits := len(name)
+	for digits > 0 && name[digits-1] >= '0' && name[digits-1] <= '9' {
+		digits--
+	}
+
+	day, err := strconv.Atoi(name[digits:])
+	if err != nil || day < 1 || day > 30 {
+		return 0, false
+	}
+	return day, true
+}
+
+func moonPhaseOfLunarDay(day int) MoonPhase {
+	switch {
+	case day < 1 || day > 30:
+		return MoonPhaseUnknown
+	case day <= 1 || day >= 29:
+		return NewMoon
+	case day <= 6:
+		return WaxingCrescent
+	case day <= 8:
+		return FirstQuarter
+	case day <= 13:
+		return WaxingGibbous
+	case day <= 16:
+		return FullMoon
+	case day <= 21:
+		return WaningGibbous
+	case day <= 23:
+		return LastQuarter
+	default:
+		return WaningCrescent
+	}
+}
@@ -0,0 +1,135 @@
+package diyanet
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMQTTTopic is the topic prefix of [MQTTPublisher] when none is given.
+const DefaultMQTTTopic = "diyanet"
+
+// MQTTClient publishes MQTT messages. This package does not depend on an MQTT library;
+// wrap the client of your choice, e.g. github.com/eclipse/paho.mqtt.golang:
+//
+//	type pahoClient struct{ mqtt.Client }
+//
+//	func (c pahoClient) Publish(topic string, qos byte, retained bool, payload []byte) error {
+//		token := c.Client.Publish(topic, qos, retained, payload)
+//		token.Wait()
+//		return token.Error()
+//	}
+type MQTTClient interface {
+	// Publish publishes the payload to the topic with the given quality of service.
+	Publish(topic string, qos byte, retained bool, payload []byte) error
+}
+
+// MQTTPublisher publishes the events of a [Scheduler] to MQTT for smart-home automations,
+// see [Scheduler.OnMQTT]. All payloads are JSON objects.
+//
+// Per city, it publishes to the following topics below Topic:
+//
+//	<topic>/<city id>/prayer    at every prayer time
+//	<topic>/<city id>/reminder  at every reminder given in Reminders
+//	<topic>/<city id>/next      the next prayer, retained, whenever it changes
+type MQTTPublisher struct {
+	// Client publishes the messages.
+	Client MQTTClient
+	// Topic is the prefix of the topics. Defaults to [DefaultMQTTTopic].
+	Topic string
+	// QoS is the MQTT quality of service of all messages: 0, 1 or 2.
+	QoS byte
+	// Reminders are published in addition to the prayer times, e.g. 15 minutes before every prayer.
+	Reminders []Reminder
+}
+
+// mqttEvent is the payload of a prayer or reminder message.
+type mqttEvent struct {
+	CityId        int        `json:"cityId"`
+	City          string     `json:"city"`
+	Prayer        PrayerName `json:"prayer"`
+	PrayerTime    time.Time  `json:"prayerTime"`
+	OffsetMinutes float64    `json:"offsetMinutes,omitempty"`
+	Late          bool       `json:"late,omitempty"`
+}
+
+// mqttNext is the payload of a next prayer message.
+type mqttNext struct {
+	CityId     int        `json:"cityId"`
+	City       string     `json:"city"`
+	Prayer     PrayerName `json:"prayer"`
+	PrayerTime time.Time  `json:"prayerTime"`
+}
+
+// OnMQTT registers the publisher with the scheduler. The next prayer of every city is published
+// when [Scheduler.Run] starts and after every prayer time. Errors are logged.
+func (s *Scheduler) OnMQTT(p MQTTPublisher) {
+	s.OnPrayer(func(event PrayerEvent) {
+		p.publishEvent("prayer", event)
+		p.publishNext(s, event.City, event.Time)
+	})
+	for _, reminder := range p.Reminders {
+		s.OnReminder(reminder, func(event PrayerEvent) {
+			p.publishEvent("reminder", event)
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.startHandlers = append(s.startHandlers, func() {
+		now := s.clock().Now()
+		for _, city := range s.cities {
+			p.publishNext(s, city, now)
+		}
+	})
+}
+
+// publishEvent publishes a prayer or reminder event.
+func (p MQTTPublisher) publishEvent(kind string, event PrayerEvent) {
+	p.publish(event.City, kind, false, mqttEvent{
+		CityId:        event.City.Id,
+		City:          event.City.Name,
+		Prayer:        event.Prayer.Name,
+		PrayerTime:    event.Prayer.Time,
+		OffsetMinutes: event.Offset.Minutes(),
+		Late:          event.Late,
+	})
+}
+
+// publishNext publishes the first prayer of the city after now as retained message.
+func (p MQTTPublisher) publishNext(s *Scheduler, city City, now time.Time) {
+	next, err := s.nextPrayer(city, now)
+	if err != nil {
+		log.Printf("%s; not publishing next prayer of city %s (%d – %s)", err, city.Name, city.Id, city.Code)
+		return
+	}
+	p.publish(city, "next", true, mqttNext{
+		CityId:     city.Id,
+		City:       city.Name,
+		Prayer:     next.Name,
+		PrayerTime: next.Time,
+	})
+}
+
+// publish publishes the payload as JSON to the topic of the city and kind.
+func (p MQTTPublisher) publish(city City, kind string, retained bool, payload any) {
+	data, err := json.Marshal(payload)
+	if err == nil {
+		err = p.Client.Publish(p.topic(city, kind), p.QoS, retained, data)
+	}
+	if err != nil {
+		log.Printf("%s; MQTT message not published", fmt.Errorf(errorPrefix+"unable to publish %s: %w", p.topic(city, kind), err))
+	}
+}
+
+// topic returns the topic of the city and kind.
+func (p MQTTPublisher) topic(city City, kind string) string {
+	prefix := strings.TrimSuffix(p.Topic, "/")
+	if prefix == "" {
+		prefix = DefaultMQTTTopic
+	}
+	return prefix + "/" + strconv.Itoa(city.Id) + "/" + kind
+}
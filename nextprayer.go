@@ -0,0 +1,117 @@
+package diyanet
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ErrNoUpcomingPrayer is returned when the given prayer times contain no prayer after the reference time.
+var ErrNoUpcomingPrayer = errors.New(errorPrefix + "no upcoming prayer in the given prayer times")
+
+// ErrNoCurrentPrayer is returned when the given prayer times contain no prayer at or before the reference time.
+var ErrNoCurrentPrayer = errors.New(errorPrefix + "no current prayer in the given prayer times")
+
+// UpcomingPrayer is the next prayer relative to a reference time.
+type UpcomingPrayer struct {
+	Prayer
+	// Remaining is the duration from the reference time until the prayer begins.
+	Remaining time.Duration
+}
+
+// PrayerPeriod is the window between two consecutive prayer times.
+type PrayerPeriod struct {
+	// Current is the prayer time that started the window.
+	Current Prayer
+	// Next is the prayer time that ends the window.
+	Next Prayer
+}
+
+// String returns a description such as "between Asr and Maghrib".
+func (p PrayerPeriod) String() string {
+	return fmt.Sprintf("between %s and %s", p.Current.Name, p.Next.Name)
+}
+
+// Duration returns the length of the window.
+func (p PrayerPeriod) Duration() time.Duration {
+	return p.Next.Time.Sub(p.Current.Time)
+}
+
+// NextPrayer returns the first prayer in times that begins after now.
+// The prayer times may be the result of a daily, weekly or monthly request and need not be sorted.
+// If no prayer begins after now, [ErrNoUpcomingPrayer] is returned.
+func NextPrayer(times []PrayerTime, now time.Time) (UpcomingPrayer, error) {
+	prayers, err := sortedPrayers(times)
+	if err != nil {
+		return UpcomingPrayer{}, err
+	}
+
+	i := firstPrayerAfter(prayers, now)
+	if i == len(prayers) {
+		return UpcomingPrayer{}, ErrNoUpcomingPrayer
+	}
+
+	return UpcomingPrayer{Prayer: prayers[i], Remaining: prayers[i].Time.Sub(now)}, nil
+}
+
+// TimeUntilNextPrayer returns the duration from now until the next prayer in times begins.
+// If no prayer begins after now, [ErrNoUpcomingPrayer] is returned.
+func TimeUntilNextPrayer(times []PrayerTime, now time.Time) (time.Duration, error) {
+	next, err := NextPrayer(times, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return next.Remaining, nil
+}
+
+// CurrentPrayer returns the prayer window that is active at now, i.e. the last prayer time
+// at or before now and the prayer time following it.
+// If no prayer time begins at or before now, [ErrNoCurrentPrayer] is returned;
+// if none begins after now, [ErrNoUpcomingPrayer] is returned.
+func CurrentPrayer(times []PrayerTime, now time.Time) (PrayerPeriod, error) {
+	prayers, err := sortedPrayers(times)
+	if err != nil {
+		return PrayerPeriod{}, err
+	}
+
+	i := firstPrayerAfter(prayers, now)
+	if i == 0 {
+		return PrayerPeriod{}, ErrNoCurrentPrayer
+	}
+	if i == len(prayers) {
+		return PrayerPeriod{}, ErrNoUpcomingPrayer
+	}
+
+	return PrayerPeriod{Current: prayers[i-1], Next: prayers[i]}, nil
+}
+
+// sortedPrayers returns the prayers of all given days in chronological order.
+func sortedPrayers(times []PrayerTime) ([]Prayer, error) {
+	prayers := make([]Prayer, 0, 6*len(times))
+	for _, pt := range times {
+		day, err := pt.Prayers()
+		if err != nil {
+			return nil, err
+		}
+		prayers = append(prayers, day...)
+	}
+
+	slices.SortStableFunc(prayers, func(a, b Prayer) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	return prayers, nil
+}
+
+// firstPrayerAfter returns the index of the first prayer in the sorted prayers that begins after now.
+func firstPrayerAfter(prayers []Prayer, now time.Time) int {
+	i, _ := slices.BinarySearchFunc(prayers, now, func(p Prayer, t time.Time) int {
+		if p.Time.After(t) {
+			return 1
+		}
+		return -1
+	})
+	return i
+}
@@ -0,0 +1,144 @@
+package diyanet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefaultNominatimURL is the public OpenStreetMap Nominatim instance.
+const DefaultNominatimURL = "https://nominatim.openstreetmap.org/"
+
+// DefaultNominatimUserAgent identifies this package to Nominatim servers when no UserAgent is set.
+const DefaultNominatimUserAgent = "DiyanetAwqatSalahAPI (+https://github.com/abduelhamit/DiyanetAwqatSalahAPI)"
+
+// Nominatim is a [Geocoder] backed by an OpenStreetMap Nominatim server.
+//
+// The public instance requires an identifying UserAgent and allows at most one request per second;
+// see https://operations.osmfoundation.org/policies/nominatim/.
+type Nominatim struct {
+	// BaseURL is the URL of the Nominatim server. Defaults to [DefaultNominatimURL].
+	BaseURL string
+	// UserAgent identifies the application to the server. Defaults to [DefaultNominatimUserAgent]; applications
+	// using the public instance should set their own.
+	UserAgent string
+	// HTTPClient is the HTTP client used to make requests. Defaults to [http.DefaultClient].
+	HTTPClient *http.Client
+}
+
+// nominatimPlace is a place as returned by the Nominatim search and reverse endpoints.
+type nominatimPlace struct {
+	Lat     string            `json:"lat"`
+	Lon     string            `json:"lon"`
+	Address map[string]string `json:"address"`
+	Error   string            `json:"error"`
+}
+
+// nominatimLocalities are the address keys of Nominatim that name localities, most specific first.
+var nominatimLocalities = []string{
+	"village", "town", "suburb", "city_district", "municipality", "city", "county", "province",
+}
+
+// Geocode implements [Geocoder].
+func (n Nominatim) Geocode(ctx context.Context, address string) ([]Address, error) {
+	query := url.Values{"q": {address}, "format": {"jsonv2"}, "addressdetails": {"1"}, "limit": {"5"}}
+
+	var places []nominatimPlace
+	if err := n.get(ctx, "search", query, &places); err != nil {
+		return nil, err
+	}
+
+	addresses := make([]Address, 0, len(places))
+	for _, place := range places {
+		address, err := place.address()
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+// Reverse implements [Geocoder].
+func (n Nominatim) Reverse(ctx context.Context, at Coordinates) (Address, error) {
+	query := url.Values{
+		"lat":            {strconv.FormatFloat(at.Latitude, 'f', -1, 64)},
+		"lon":            {strconv.FormatFloat(at.Longitude, 'f', -1, 64)},
+		"format":         {"jsonv2"},
+		"addressdetails": {"1"},
+		"zoom":           {"14"},
+	}
+
+	var place nominatimPlace
+	if err := n.get(ctx, "reverse", query, &place); err != nil {
+		return Address{}, err
+	}
+	if place.Error != "" {
+		return Address{}, fmt.Errorf(errorPrefix+"nominatim reverse request failed: %s", place.Error)
+	}
+	return place.address()
+}
+
+func (n Nominatim) get(ctx context.Context, endpoint string, query url.Values, v any) error {
+	base := n.BaseURL
+	if base == "" {
+		base = DefaultNominatimURL
+	}
+	client := n.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET",
+		strings.TrimSuffix(base, "/")+"/"+endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf(errorPrefix+"unable to create nominatim %s request: %w", endpoint, err)
+	}
+	userAgent := n.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultNominatimUserAgent
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf(errorPrefix+"nominatim %s request failed: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errorPrefix+"nominatim %s request failed: %s", endpoint, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to decode nominatim %s response: %w", endpoint, err)
+	}
+	return nil
+}
+
+func (p nominatimPlace) address() (Address, error) {
+	lat, err := strconv.ParseFloat(p.Lat, 64)
+	if err != nil {
+		return Address{}, fmt.Errorf(errorPrefix+"invalid nominatim latitude %q: %w", p.Lat, err)
+	}
+	lon, err := strconv.ParseFloat(p.Lon, 64)
+	if err != nil {
+		return Address{}, fmt.Errorf(errorPrefix+"invalid nominatim longitude %q: %w", p.Lon, err)
+	}
+
+	address := Address{
+		Coordinates: Coordinates{Latitude: lat, Longitude: lon},
+		CountryCode: strings.ToUpper(p.Address["country_code"]),
+		Country:     p.Address["country"],
+		State:       p.Address["state"],
+	}
+	for _, key := range nominatimLocalities {
+		if name := p.Address[key]; name != "" {
+			address.Localities = append(address.Localities, name)
+		}
+	}
+	return address, nil
+}
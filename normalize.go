@@ -0,0 +1,58 @@
+package diyanet
+
+import (
+	"strings"
+	"unicode"
+)
+
+// placeNameFolding maps lower-case letters with diacritics to their plain Latin equivalents.
+var placeNameFolding = map[rune]string{
+	'ç': "c", 'ş': "s", 'ğ': "g", 'ü': "u", 'ö': "o", 'ı': "i",
+	'â': "a", 'î': "i", 'û': "u",
+	'á': "a", 'à': "a", 'ä': "a", 'å': "a", 'ã': "a", 'ă': "a", 'ą': "a",
+	'é': "e", 'è': "e", 'ê': "e", 'ë': "e", 'ę': "e", 'ě': "e",
+	'í': "i", 'ì': "i", 'ï': "i",
+	'ó': "o", 'ò': "o", 'ô': "o", 'õ': "o", 'ø': "o", 'ő': "o",
+	'ú': "u", 'ù': "u", 'ů': "u", 'ű': "u",
+	'ñ': "n", 'ń': "n", 'ň': "n",
+	'ć': "c", 'č': "c", 'ś': "s", 'š': "s", 'ș': "s",
+	'ž': "z", 'ź': "z", 'ż': "z", 'ř': "r", 'ł': "l", 'ď': "d", 'đ': "d", 'ť': "t", 'ț': "t",
+	'ý': "y", 'ÿ': "y",
+	'ß': "ss", 'æ': "ae", 'œ': "oe",
+}
+
+// NormalizePlaceName prepares a place name for comparison. Following Turkish rules, İ/I/i/ı are
+// treated as the same letter, as are ç/c, ş/s, ğ/g, ö/o and ü/u; other common diacritics are removed
+// as well. The result is lower case, with punctuation replaced by single spaces, so that
+// "İSTANBUL" and "istanbul", or "K. Maraş" and "k maras", compare equal.
+//
+// All place search and lookup helpers of this package compare names in this form.
+func NormalizePlaceName(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	space := true
+	for _, r := range s {
+		switch r {
+		case 'İ', 'I':
+			r = 'i'
+		default:
+			r = unicode.ToLower(r)
+		}
+
+		switch folded, ok := placeNameFolding[r]; {
+		case ok:
+			b.WriteString(folded)
+			space = false
+		case unicode.Is(unicode.Mn, r):
+			// Drop combining marks such as the dot of a decomposed "i̇".
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+			space = false
+		case !space:
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), " ")
+}
@@ -0,0 +1,223 @@
+package diyanet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Notification is a message about a prayer event or the daily content, as sent by a [Notifier].
+type Notification struct {
+	// Title is a short summary, e.g. "Asr in 15 minutes – İSTANBUL".
+	Title string
+	// Text is the plain text body.
+	Text string
+	// Event is the prayer event the notification is about, if any.
+	Event *PrayerEvent
+	// DailyContent is the daily content the notification is about, if any.
+	DailyContent *DailyContent
+	// Summary is the day of prayer times the notification summarizes, if any.
+	Summary *PrayerTime
+}
+
+// NewPrayerNotification returns a notification about a prayer event fired by a [Scheduler],
+// titled by the prayer, the offset of a reminder and the city.
+func NewPrayerNotification(event PrayerEvent) Notification {
+	title := event.Prayer.Name.String()
+	switch minutes := int(event.Offset.Round(time.Minute).Minutes()); {
+	case minutes < 0:
+		title += fmt.Sprintf(" in %d minutes", -minutes)
+	case minutes > 0:
+		title += fmt.Sprintf(" %d minutes ago", minutes)
+	}
+	if event.City.Name != "" {
+		title += " – " + event.City.Name
+	}
+
+	return Notification{
+		Title: title,
+		Text:  fmt.Sprintf("%s begins at %s.", event.Prayer.Name, event.Prayer.Time.Format("15:04")),
+		Event: &event,
+	}
+}
+
+// NewIftarNotification returns a countdown notification to iftar for a reminder before Maghrib,
+// e.g. registered with [Scheduler.OnReminder] for the Maghrib prayers of Ramadan.
+func NewIftarNotification(event PrayerEvent) Notification {
+	n := NewPrayerNotification(event)
+	n.Title = "Iftar"
+	if minutes := -int(event.Offset.Round(time.Minute).Minutes()); minutes > 0 {
+		n.Title += fmt.Sprintf(" in %d minutes", minutes)
+	}
+	if event.City.Name != "" {
+		n.Title += " – " + event.City.Name
+	}
+	n.Text = fmt.Sprintf("Iftar (%s) is at %s.", event.Prayer.Name, event.Prayer.Time.Format("15:04"))
+	return n
+}
+
+// NewSummaryNotification returns a notification listing the prayer times of the day in the city,
+// e.g. for a morning message sent with [Scheduler.OnDailySummary].
+func NewSummaryNotification(city City, day PrayerTime) Notification {
+	title := "Prayer times " + day.GregorianDate.Format("02.01.2006")
+	if city.Name != "" {
+		title += " – " + city.Name
+	}
+
+	var lines []string
+	for _, name := range PrayerNames {
+		lines = append(lines, name.String()+" "+day.Clock(name))
+	}
+	return Notification{
+		Title:   title,
+		Text:    strings.Join(lines, "\n"),
+		Summary: &day,
+	}
+}
+
+// NewDailyContentNotification returns a notification with the verse, hadith and prayer of the daily content.
+func NewDailyContentNotification(content *DailyContent) Notification {
+	var parts []string
+	for _, part := range content.parts() {
+		parts = append(parts, strings.TrimSpace(part.Text+"\n"+part.Source))
+	}
+
+	return Notification{
+		Title:        "Daily content",
+		Text:         strings.Join(parts, "\n\n"),
+		DailyContent: content,
+	}
+}
+
+// NotificationFilter selects the notifications a recipient receives. The zero value selects all.
+type NotificationFilter struct {
+	// CityIDs restricts prayer and summary notifications to the given cities. If empty, all cities are selected.
+	CityIDs []int
+	// Prayers restricts prayer notifications to the given prayers. If empty, all prayers are selected.
+	Prayers []PrayerName
+	// NoDailyContent deselects daily content notifications.
+	NoDailyContent bool
+	// QuietStart and QuietEnd, if both set, deselect all notifications between these local clock times
+	// in the format "15:04", e.g. "23:00" and "05:00". Prayer notifications are judged by the time of
+	// their event, others by the time they are sent.
+	QuietStart, QuietEnd string
+}
+
+// Matches reports whether the filter selects the notification.
+func (f NotificationFilter) Matches(n Notification) bool {
+	if f.quiet(n) {
+		return false
+	}
+	if n.DailyContent != nil && f.NoDailyContent {
+		return false
+	}
+	if n.Event != nil {
+		return (len(f.CityIDs) == 0 || slices.Contains(f.CityIDs, n.Event.City.Id)) &&
+			(len(f.Prayers) == 0 || slices.Contains(f.Prayers, n.Event.Prayer.Name))
+	}
+	if n.Summary != nil {
+		return len(f.CityIDs) == 0 || slices.Contains(f.CityIDs, n.Summary.CityId)
+	}
+	return true
+}
+
+// quiet reports whether the notification falls into the quiet hours.
+// Invalid quiet hours are ignored.
+func (f NotificationFilter) quiet(n Notification) bool {
+	if f.QuietStart == "" || f.QuietEnd == "" {
+		return false
+	}
+	at := time.Now()
+	if n.Event != nil {
+		at = n.Event.Time
+	}
+	start, err := parseClock(at, f.QuietStart)
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(at, f.QuietEnd)
+	if err != nil {
+		return false
+	}
+
+	if start.Before(end) {
+		return !at.Before(start) && at.Before(end)
+	}
+	// The quiet hours span midnight.
+	return !at.Before(start) || at.Before(end)
+}
+
+// truncate shortens s to at most n characters, ending it with an ellipsis if shortened.
+func truncate(s string, n int) string {
+	if runes := []rune(s); len(runes) > n {
+		return string(runes[:n-1]) + "…"
+	}
+	return s
+}
+
+// postJSON posts the payload as JSON to endpoint and decodes the JSON response into response unless nil.
+// Responses with a status other than 2xx are reported as errors of the named service.
+func postJSON(ctx context.Context, client *http.Client, service, endpoint string, header http.Header, payload, response any) error {
+	return sendJSON(ctx, client, "POST", service, endpoint, header, payload, response)
+}
+
+// sendJSON is like postJSON with the given request method.
+func sendJSON(ctx context.Context, client *http.Client, method, service, endpoint string, header http.Header, payload, response any) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf(errorPrefix+"unable to encode %s request: %w", service, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// The URL may hold secrets such as bot tokens or webhook keys; do not report it.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf(errorPrefix+"%s request failed: %w", service, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &statusError{service: service, resp: resp, detail: bytes.TrimSpace(detail)}
+	}
+	if response != nil {
+		if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+			return fmt.Errorf(errorPrefix+"unable to decode %s response: %w", service, err)
+		}
+	}
+	return nil
+}
+
+// statusError reports a response of a service with a status other than 2xx.
+type statusError struct {
+	service string
+	resp    *http.Response
+	detail  []byte
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf(errorPrefix+"%s request failed: %s: %s", e.service, e.resp.Status, e.detail)
+}
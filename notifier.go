@@ -0,0 +1,79 @@
+package diyanet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// Notifier sends notifications to a messaging service. It is implemented by [TelegramNotifier],
+// [DiscordNotifier], [SlackNotifier], [NtfyNotifier], [GotifyNotifier], [MatrixNotifier] and [Dispatcher].
+type Notifier interface {
+	// Send sends the notification to the recipients selected by the notifier's own preferences.
+	Send(ctx context.Context, n Notification) error
+}
+
+// NotifierFunc is an adapter to allow the use of ordinary functions as [Notifier].
+type NotifierFunc func(ctx context.Context, n Notification) error
+
+// Send implements [Notifier].
+func (f NotifierFunc) Send(ctx context.Context, n Notification) error {
+	return f(ctx, n)
+}
+
+// Dispatcher is a [Notifier] fanning out notifications to several backends, each with its own filter.
+type Dispatcher struct {
+	// Backends receive the notifications.
+	Backends []NotifierBackend
+}
+
+// NotifierBackend is a backend of a [Dispatcher].
+type NotifierBackend struct {
+	// Name identifies the backend in errors, e.g. "telegram".
+	Name string
+	// Notifier sends the notifications.
+	Notifier Notifier
+	// Filter selects the notifications sent to the backend, e.g. no notifications during quiet hours.
+	Filter NotificationFilter
+}
+
+// Send sends the notification to all backends whose filter selects it, concurrently.
+// It waits for all deliveries and returns the errors of the failed backends joined,
+// each naming its backend.
+func (d Dispatcher) Send(ctx context.Context, n Notification) error {
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(d.Backends))
+	)
+	for i, backend := range d.Backends {
+		if !backend.Filter.Matches(n) {
+			continue
+		}
+		wg.Go(func() {
+			if err := backend.Notifier.Send(ctx, n); err != nil {
+				errs[i] = fmt.Errorf("%w (backend %s)", err, backend.Name)
+			}
+		})
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// OnNotify registers the notifier to be sent a notification, made by newNotification
+// (e.g. [NewPrayerNotification]), for every event selected by the reminder.
+// Notifications are sent in their own goroutine with ctx; delivery errors are logged.
+// Late events (see [PrayerEvent.Late]) are skipped.
+func (s *Scheduler) OnNotify(ctx context.Context, notifier Notifier, reminder Reminder, newNotification func(PrayerEvent) Notification) {
+	s.OnReminder(reminder, func(event PrayerEvent) {
+		if event.Late {
+			return
+		}
+		go func() {
+			if err := notifier.Send(ctx, newNotification(event)); err != nil {
+				log.Printf("%s; notification not delivered", err)
+			}
+		}()
+	})
+}
@@ -0,0 +1,121 @@
+package diyanet
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// ErrOffline is returned, wrapped, in offline mode (see [Config.OfflineOnly]) if a response is not cached.
+var ErrOffline = errors.New(errorPrefix + "not available offline")
+
+// DefaultPrefetchTTL is the time prefetched responses are cached for when [PrefetchSpec.TTL] is zero.
+const DefaultPrefetchTTL = 30 * 24 * time.Hour
+
+// PrefetchSpec describes the data downloaded by [Client.Prefetch].
+type PrefetchSpec struct {
+	// Places downloads all countries, states and cities.
+	Places bool
+	// CityIDs lists the cities whose details and monthly prayer times are downloaded.
+	// The monthly prayer times cover the next 30 days; the API offers no later dates.
+	CityIDs []int
+	// Ramadan additionally downloads the Ramadan prayer times of the cities.
+	Ramadan bool
+	// TTL is the minimum time the downloaded responses are cached for. Defaults to [DefaultPrefetchTTL].
+	TTL time.Duration
+	// Concurrency is the maximum number of concurrent downloads. Defaults to 8.
+	Concurrency int
+	// Progress, if set, is called after every completed download. It may be called concurrently.
+	Progress func(PrefetchProgress)
+}
+
+// PrefetchProgress reports the progress of [Client.Prefetch].
+type PrefetchProgress struct {
+	// Done is the number of completed downloads.
+	Done int
+	// Total is the number of downloads.
+	Total int
+	// Item describes the completed download, e.g. "monthly prayer times of city 9541".
+	Item string
+}
+
+// Prefetch downloads the data described by spec into the cache, for example to provision a device
+// or to use the client in offline mode later (see [Config.OfflineOnly]). It works even if the client
+// is in offline mode. Prefetch stops at the first failed download and returns its error.
+func (c Client) Prefetch(spec PrefetchSpec) error {
+	c.offline = false
+	c.prefetchTTL = spec.TTL
+	if c.prefetchTTL <= 0 {
+		c.prefetchTTL = DefaultPrefetchTTL
+	}
+	concurrency := spec.Concurrency
+	if concurrency <= 0 {
+		concurrency = placeLoadConcurrency
+	}
+
+	type task struct {
+		item  string
+		fetch func() error
+	}
+	var tasks []task
+	if spec.Places {
+		tasks = append(tasks, task{"places", func() error {
+			_, err := c.LoadPlaces()
+			return err
+		}})
+	}
+	for _, id := range spec.CityIDs {
+		city := City{client: c, Id: id}
+		tasks = append(tasks,
+			task{fmt.Sprintf("details of city %d", id), func() error {
+				_, err := c.GetCityDetail(id)
+				return err
+			}},
+			task{fmt.Sprintf("monthly prayer times of city %d", id), func() error {
+				_, err := city.GetPrayerTimeMonthly(time.UTC)
+				return err
+			}})
+		if spec.Ramadan {
+			tasks = append(tasks, task{fmt.Sprintf("Ramadan prayer times of city %d", id), func() error {
+				_, err := city.GetPrayerTimeRamadan(time.UTC)
+				return err
+			}})
+		}
+	}
+
+	var done atomic.Int64
+	return forEachConcurrently(len(tasks), concurrency, func(i int) error {
+		if err := tasks[i].fetch(); err != nil {
+			return err
+		}
+		n := done.Add(1)
+		if spec.Progress != nil {
+			spec.Progress(PrefetchProgress{Done: int(n), Total: len(tasks), Item: tasks[i].item})
+		}
+		return nil
+	})
+}
+
+// offlineGet returns the cached response for url, regardless of whether the endpoint is cached when online.
+func (c Client) offlineGet(url string) ([]byte, error) {
+	if body, ok := c.peek(url); ok {
+		return body, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrOffline, url)
+}
+
+// peek returns the cached response for url without counting a cache hit or triggering a refresh.
+func (c Client) peek(url string) ([]byte, bool) {
+	if c.cache == nil {
+		return nil, false
+	}
+	value, ok := c.cache.Get(c.ctx, c.cacheKey(url))
+	if !ok {
+		return nil, false
+	}
+	if body, _, ok := decodeStale(value); ok {
+		return body, true
+	}
+	return value, true
+}
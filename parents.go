@@ -0,0 +1,94 @@
+package diyanet
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// errParentFound stops the concurrent parent discovery once the parent has been found.
+var errParentFound = errors.New("parent found")
+
+// State returns the state the city belongs to.
+// If the city was retrieved via [State.GetCities] (including [Client.LoadPlaces]), the state is known;
+// otherwise it is discovered by searching the cities of all states via the Diyanet Awqat Salah API.
+func (c City) State() (State, error) {
+	if c.state != nil {
+		return *c.state, nil
+	}
+
+	states, err := c.client.GetStates()
+	if err != nil {
+		return State{}, err
+	}
+
+	var (
+		mu    sync.Mutex
+		found *State
+	)
+	err = forEachConcurrently(len(states), placeLoadConcurrency, func(i int) error {
+		cities, err := states[i].GetCities()
+		if err != nil {
+			return err
+		}
+		for _, city := range cities {
+			if city.Id == c.Id {
+				mu.Lock()
+				found = &states[i]
+				mu.Unlock()
+				return errParentFound
+			}
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errParentFound) {
+		return State{}, err
+	}
+	if found == nil {
+		return State{}, fmt.Errorf(errorPrefix+"state of city %s (%d – %s) not found", c.Name, c.Id, c.Code)
+	}
+
+	return *found, nil
+}
+
+// Country returns the country the state belongs to.
+// If the state was retrieved via [Country.GetStates] (including [Client.LoadPlaces]), the country is known;
+// otherwise it is discovered by searching the states of all countries via the Diyanet Awqat Salah API.
+func (s State) Country() (Country, error) {
+	if s.country != nil {
+		return *s.country, nil
+	}
+
+	countries, err := s.client.GetCountries()
+	if err != nil {
+		return Country{}, err
+	}
+
+	var (
+		mu    sync.Mutex
+		found *Country
+	)
+	err = forEachConcurrently(len(countries), placeLoadConcurrency, func(i int) error {
+		states, err := countries[i].GetStates()
+		if err != nil {
+			return err
+		}
+		for _, state := range states {
+			if state.Id == s.Id {
+				mu.Lock()
+				found = &countries[i]
+				mu.Unlock()
+				return errParentFound
+			}
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errParentFound) {
+		return Country{}, err
+	}
+	if found == nil {
+		return Country{}, fmt.Errorf(errorPrefix+"country of state %s (%d – %s) not found", s.Name, s.Id, s.Code)
+	}
+
+	return *found, nil
+}
@@ -0,0 +1,64 @@
+package diyanet
+
+import "strings"
+
+// trigramPadding marks the start of a name so that the first trigrams of a name double as a prefix index.
+const trigramPadding = "^^"
+
+// FindCity searches the cities of the tree by name like [Client.FindCity], without any API requests.
+// Candidates are looked up in a trigram index built when the tree is loaded, so a search only scores
+// the cities sharing at least one trigram with the query instead of every city.
+func (t *PlaceTree) FindCity(query string, opts FindCityOptions) []CityMatch {
+	candidates := make(map[*CityNode]bool)
+	for _, trigram := range trigramsOf(query) {
+		for _, city := range t.trigrams[trigram] {
+			candidates[city] = true
+		}
+	}
+
+	var matches []CityMatch
+	for city := range candidates {
+		if opts.Country != "" && !cityInCountry(city, opts.Country) {
+			continue
+		}
+		if score := matchScore(query, city.City.Name); score >= minMatchScore {
+			matches = append(matches, CityMatch{City: city.City, Score: score})
+		}
+	}
+
+	return rankMatches(matches, opts.Limit)
+}
+
+func (t *PlaceTree) addTrigrams(city *CityNode) {
+	for _, trigram := range trigramsOf(city.City.Name) {
+		t.trigrams[trigram] = append(t.trigrams[trigram], city)
+	}
+}
+
+// cityInCountry reports whether the city belongs to the country with the given code or name.
+func cityInCountry(city *CityNode, country string) bool {
+	if city.State == nil || city.State.Country == nil {
+		return false
+	}
+	c := city.State.Country.Country
+	return c.Code == country || NormalizePlaceName(c.Name) == NormalizePlaceName(country)
+}
+
+// trigramsOf returns the distinct trigrams of the normalized, space-free name, including the padded prefix trigrams.
+func trigramsOf(name string) []string {
+	runes := []rune(trigramPadding + strings.ReplaceAll(NormalizePlaceName(name), " ", ""))
+	if len(runes) == len(trigramPadding) {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(runes))
+	var trigrams []string
+	for i := 0; i+3 <= len(runes); i++ {
+		trigram := string(runes[i : i+3])
+		if !seen[trigram] {
+			seen[trigram] = true
+			trigrams = append(trigrams, trigram)
+		}
+	}
+	return trigrams
+}
@@ -0,0 +1,170 @@
+package diyanet
+
+// placeLoadConcurrency is the number of concurrent requests made by [Client.LoadPlaces].
+const placeLoadConcurrency = 8
+
+// PlaceTree is the hierarchy of all countries, states and cities known to the Diyanet Awqat Salah API,
+// indexed by ID and by name.
+type PlaceTree struct {
+	// Countries holds all countries with their states and cities.
+	Countries []*CountryNode
+
+	countries map[int]*CountryNode
+	states    map[int]*StateNode
+	cities    map[int]*CityNode
+	names     map[string][]any
+	trigrams  map[string][]*CityNode
+}
+
+// CountryNode is a country within a [PlaceTree].
+type CountryNode struct {
+	// Country is the country.
+	Country Country
+	// States holds the states of the country.
+	States []*StateNode
+}
+
+// StateNode is a state within a [PlaceTree].
+type StateNode struct {
+	// State is the state.
+	State State
+	// Country is the country the state belongs to.
+	Country *CountryNode
+	// Cities holds the cities of the state.
+	Cities []*CityNode
+}
+
+// CityNode is a city within a [PlaceTree].
+type CityNode struct {
+	// City is the city.
+	City City
+	// State is the state the city belongs to.
+	State *StateNode
+}
+
+// LoadPlaces retrieves all countries and, concurrently, their states and cities from the Diyanet Awqat Salah API
+// and links them into a [PlaceTree].
+func (c Client) LoadPlaces() (*PlaceTree, error) {
+	countries, err := c.GetCountries()
+	if err != nil {
+		return nil, err
+	}
+
+	tree := &PlaceTree{Countries: make([]*CountryNode, len(countries))}
+	for i, country := range countries {
+		tree.Countries[i] = &CountryNode{Country: country}
+	}
+
+	err = forEachConcurrently(len(tree.Countries), placeLoadConcurrency, func(i int) error {
+		country := tree.Countries[i]
+		states, err := country.Country.GetStates()
+		if err != nil {
+			return err
+		}
+
+		country.States = make([]*StateNode, len(states))
+		for j, state := range states {
+			country.States[j] = &StateNode{State: state, Country: country}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var states []*StateNode
+	for _, country := range tree.Countries {
+		states = append(states, country.States...)
+	}
+
+	err = forEachConcurrently(len(states), placeLoadConcurrency, func(i int) error {
+		state := states[i]
+		cities, err := state.State.GetCities()
+		if err != nil {
+			return err
+		}
+
+		state.Cities = make([]*CityNode, len(cities))
+		for j, city := range cities {
+			state.Cities[j] = &CityNode{City: city, State: state}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tree.index()
+	return tree, nil
+}
+
+// index (re)builds the lookup maps of the tree from its countries.
+func (t *PlaceTree) index() {
+	t.countries = make(map[int]*CountryNode)
+	t.states = make(map[int]*StateNode)
+	t.cities = make(map[int]*CityNode)
+	t.names = make(map[string][]any)
+	t.trigrams = make(map[string][]*CityNode)
+
+	for _, country := range t.Countries {
+		t.countries[country.Country.Id] = country
+		t.addName(country.Country.Name, country)
+		for _, state := range country.States {
+			t.states[state.State.Id] = state
+			t.addName(state.State.Name, state)
+			for _, city := range state.Cities {
+				t.cities[city.City.Id] = city
+				t.addName(city.City.Name, city)
+				t.addTrigrams(city)
+			}
+		}
+	}
+}
+
+func (t *PlaceTree) addName(name string, node any) {
+	key := NormalizePlaceName(name)
+	t.names[key] = append(t.names[key], node)
+}
+
+// Country returns the country with the given ID.
+func (t *PlaceTree) Country(id int) (*CountryNode, bool) {
+	country, ok := t.countries[id]
+	return country, ok
+}
+
+// State returns the state with the given ID.
+func (t *PlaceTree) State(id int) (*StateNode, bool) {
+	state, ok := t.states[id]
+	return state, ok
+}
+
+// City returns the city with the given ID.
+func (t *PlaceTree) City(id int) (*CityNode, bool) {
+	city, ok := t.cities[id]
+	return city, ok
+}
+
+// CountriesByName returns the countries with the given name, compared in the form of [NormalizePlaceName].
+func (t *PlaceTree) CountriesByName(name string) []*CountryNode {
+	return nodesByName[*CountryNode](t, name)
+}
+
+// StatesByName returns the states with the given name, compared in the form of [NormalizePlaceName].
+func (t *PlaceTree) StatesByName(name string) []*StateNode {
+	return nodesByName[*StateNode](t, name)
+}
+
+// CitiesByName returns the cities with the given name, compared in the form of [NormalizePlaceName].
+func (t *PlaceTree) CitiesByName(name string) []*CityNode {
+	return nodesByName[*CityNode](t, name)
+}
+
+func nodesByName[N any](t *PlaceTree, name string) []N {
+	var nodes []N
+	for _, node := range t.names[NormalizePlaceName(name)] {
+		if n, ok := node.(N); ok {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,148 @@
+package diyanet
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// PlaceLevel is the level of a place in the hierarchy.
+type PlaceLevel int
+
+// The levels of the place hierarchy.
+const (
+	LevelCountry PlaceLevel = iota + 1
+	LevelState
+	LevelCity
+)
+
+var placeLevelStrings = [...]string{LevelCountry: "country", LevelState: "state", LevelCity: "city"}
+
+// String returns the name of the level, e.g. "city".
+func (l PlaceLevel) String() string {
+	if l <= 0 || int(l) >= len(placeLevelStrings) {
+		return fmt.Sprintf("PlaceLevel(%d)", int(l))
+	}
+	return placeLevelStrings[l]
+}
+
+// PlaceChangeKind classifies a difference between two place snapshots.
+type PlaceChangeKind int
+
+// The kinds of differences reported by [DiffPlaces].
+const (
+	// PlaceAdded is a place only present in the new snapshot.
+	PlaceAdded PlaceChangeKind = iota + 1
+	// PlaceRemoved is a place only present in the old snapshot.
+	PlaceRemoved
+	// PlaceRenamed is a place whose name or code changed.
+	PlaceRenamed
+	// PlaceMoved is a state or city that now belongs to another parent.
+	PlaceMoved
+)
+
+var placeChangeKindStrings = [...]string{
+	PlaceAdded:   "added",
+	PlaceRemoved: "removed",
+	PlaceRenamed: "renamed",
+	PlaceMoved:   "moved",
+}
+
+// String returns the name of the change kind, e.g. "renamed".
+func (k PlaceChangeKind) String() string {
+	if k <= 0 || int(k) >= len(placeChangeKindStrings) {
+		return fmt.Sprintf("PlaceChangeKind(%d)", int(k))
+	}
+	return placeChangeKindStrings[k]
+}
+
+// PlaceChange is a difference of one place between two snapshots.
+type PlaceChange struct {
+	// Kind classifies the change.
+	Kind PlaceChangeKind
+	// Level is the level of the changed place.
+	Level PlaceLevel
+	// Id is the ID of the changed place.
+	Id int
+	// Old is the place in the old snapshot; it is empty for added places.
+	Old PlaceSnapshot
+	// New is the place in the new snapshot; it is empty for removed places.
+	New PlaceSnapshot
+}
+
+// PlaceSnapshot is the state of a place in one snapshot.
+type PlaceSnapshot struct {
+	// Code is the code of the place.
+	Code string
+	// Name is the name of the place.
+	Name string
+	// ParentId is the ID of the parent state or country, and zero for countries.
+	ParentId int
+}
+
+// String describes the change, e.g. "city 9541 renamed: ISTANBUL → İSTANBUL".
+func (c PlaceChange) String() string {
+	switch c.Kind {
+	case PlaceAdded:
+		return fmt.Sprintf("%s %d added: %s", c.Level, c.Id, c.New.Name)
+	case PlaceRemoved:
+		return fmt.Sprintf("%s %d removed: %s", c.Level, c.Id, c.Old.Name)
+	case PlaceMoved:
+		return fmt.Sprintf("%s %d (%s) moved: %d → %d", c.Level, c.Id, c.New.Name, c.Old.ParentId, c.New.ParentId)
+	}
+	return fmt.Sprintf("%s %d %s: %s (%s) → %s (%s)", c.Level, c.Id, c.Kind, c.Old.Name, c.Old.Code, c.New.Name, c.New.Code)
+}
+
+// DiffPlaces reports the countries, states and cities added, removed, renamed or moved between two
+// snapshots of the place hierarchy, so that upstream ID churn is noticed before stored city IDs break.
+// The changes are ordered by level, kind and ID.
+func DiffPlaces(old, new *PlaceTree) []PlaceChange {
+	var changes []PlaceChange
+	for _, level := range []PlaceLevel{LevelCountry, LevelState, LevelCity} {
+		before, after := old.snapshots(level), new.snapshots(level)
+
+		for id, o := range before {
+			n, ok := after[id]
+			switch {
+			case !ok:
+				changes = append(changes, PlaceChange{Kind: PlaceRemoved, Level: level, Id: id, Old: o})
+			case o.Name != n.Name || o.Code != n.Code:
+				changes = append(changes, PlaceChange{Kind: PlaceRenamed, Level: level, Id: id, Old: o, New: n})
+			}
+			if ok && o.ParentId != n.ParentId {
+				changes = append(changes, PlaceChange{Kind: PlaceMoved, Level: level, Id: id, Old: o, New: n})
+			}
+		}
+		for id, n := range after {
+			if _, ok := before[id]; !ok {
+				changes = append(changes, PlaceChange{Kind: PlaceAdded, Level: level, Id: id, New: n})
+			}
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b PlaceChange) int {
+		return cmp.Or(cmp.Compare(a.Level, b.Level), cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Id, b.Id))
+	})
+	return changes
+}
+
+// snapshots returns the places of the given level keyed by ID.
+func (t *PlaceTree) snapshots(level PlaceLevel) map[int]PlaceSnapshot {
+	snapshots := make(map[int]PlaceSnapshot)
+	for _, country := range t.Countries {
+		if level == LevelCountry {
+			snapshots[country.Country.Id] = PlaceSnapshot{Code: country.Country.Code, Name: country.Country.Name}
+			continue
+		}
+		for _, state := range country.States {
+			if level == LevelState {
+				snapshots[state.State.Id] = PlaceSnapshot{Code: state.State.Code, Name: state.State.Name, ParentId: country.Country.Id}
+				continue
+			}
+			for _, city := range state.Cities {
+				snapshots[city.City.Id] = PlaceSnapshot{Code: city.City.Code, Name: city.City.Name, ParentId: state.State.Id}
+			}
+		}
+	}
+	return snapshots
+}
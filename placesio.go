@@ -0,0 +1,201 @@
+package diyanet
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// placeCSVHeader is the header row of the CSV format of a [PlaceTree].
+var placeCSVHeader = []string{
+	"country_id", "country_code", "country_name",
+	"state_id", "state_code", "state_name",
+	"city_id", "city_code", "city_name",
+}
+
+type placeJSON struct {
+	Id     int         `json:"id"`
+	Code   string      `json:"code"`
+	Name   string      `json:"name"`
+	States []placeJSON `json:"states,omitempty"`
+	Cities []placeJSON `json:"cities,omitempty"`
+}
+
+// WriteJSON writes the place hierarchy as JSON, nesting states in countries and cities in states.
+func (t *PlaceTree) WriteJSON(w io.Writer) error {
+	doc := struct {
+		Countries []placeJSON `json:"countries"`
+	}{Countries: make([]placeJSON, 0, len(t.Countries))}
+
+	for _, country := range t.Countries {
+		c := placeJSON{Id: country.Country.Id, Code: country.Country.Code, Name: country.Country.Name}
+		for _, state := range country.States {
+			s := placeJSON{Id: state.State.Id, Code: state.State.Code, Name: state.State.Name}
+			for _, city := range state.Cities {
+				s.Cities = append(s.Cities, placeJSON{Id: city.City.Id, Code: city.City.Code, Name: city.City.Name})
+			}
+			c.States = append(c.States, s)
+		}
+		doc.Countries = append(doc.Countries, c)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write places as JSON: %w", err)
+	}
+	return nil
+}
+
+// ReadPlaceTreeJSON reads a place hierarchy written by [PlaceTree.WriteJSON].
+// The places are not bound to a client; see [PlaceTree.Attach].
+func ReadPlaceTreeJSON(r io.Reader) (*PlaceTree, error) {
+	var doc struct {
+		Countries []placeJSON `json:"countries"`
+	}
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to read places from JSON: %w", err)
+	}
+
+	tree := &PlaceTree{}
+	for _, c := range doc.Countries {
+		country := &CountryNode{Country: Country{Id: c.Id, Code: c.Code, Name: c.Name}}
+		for _, s := range c.States {
+			state := &StateNode{State: State{Id: s.Id, Code: s.Code, Name: s.Name}, Country: country}
+			for _, ci := range s.Cities {
+				state.Cities = append(state.Cities, &CityNode{City: City{Id: ci.Id, Code: ci.Code, Name: ci.Name}, State: state})
+			}
+			country.States = append(country.States, state)
+		}
+		tree.Countries = append(tree.Countries, country)
+	}
+
+	tree.link()
+	tree.index()
+	return tree, nil
+}
+
+// WriteCSV writes the place hierarchy as CSV with one row per city. Countries without states
+// and states without cities are written as rows with empty state or city columns.
+func (t *PlaceTree) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(placeCSVHeader); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write places as CSV: %w", err)
+	}
+
+	row := func(fields ...string) {
+		_ = cw.Write(append(fields, make([]string, len(placeCSVHeader)-len(fields))...))
+	}
+	for _, country := range t.Countries {
+		cf := []string{strconv.Itoa(country.Country.Id), country.Country.Code, country.Country.Name}
+		if len(country.States) == 0 {
+			row(cf...)
+		}
+		for _, state := range country.States {
+			sf := append(cf[:3:3], strconv.Itoa(state.State.Id), state.State.Code, state.State.Name)
+			if len(state.Cities) == 0 {
+				row(sf...)
+			}
+			for _, city := range state.Cities {
+				row(append(sf[:6:6], strconv.Itoa(city.City.Id), city.City.Code, city.City.Name)...)
+			}
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write places as CSV: %w", err)
+	}
+	return nil
+}
+
+// ReadPlaceTreeCSV reads a place hierarchy written by [PlaceTree.WriteCSV].
+// The places are not bound to a client; see [PlaceTree.Attach].
+func ReadPlaceTreeCSV(r io.Reader) (*PlaceTree, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(placeCSVHeader)
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to read places from CSV: %w", err)
+	}
+	if len(records) > 0 && records[0][0] == placeCSVHeader[0] {
+		records = records[1:]
+	}
+
+	tree := &PlaceTree{}
+	countries := make(map[int]*CountryNode)
+	states := make(map[int]*StateNode)
+	for i, rec := range records {
+		line := i + 2
+
+		countryID, err := strconv.Atoi(rec[0])
+		if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"invalid country ID %q in CSV line %d", rec[0], line)
+		}
+		country, ok := countries[countryID]
+		if !ok {
+			country = &CountryNode{Country: Country{Id: countryID, Code: rec[1], Name: rec[2]}}
+			countries[countryID] = country
+			tree.Countries = append(tree.Countries, country)
+		}
+		if rec[3] == "" {
+			continue
+		}
+
+		stateID, err := strconv.Atoi(rec[3])
+		if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"invalid state ID %q in CSV line %d", rec[3], line)
+		}
+		state, ok := states[stateID]
+		if !ok {
+			state = &StateNode{State: State{Id: stateID, Code: rec[4], Name: rec[5]}, Country: country}
+			states[stateID] = state
+			country.States = append(country.States, state)
+		}
+		if rec[6] == "" {
+			continue
+		}
+
+		cityID, err := strconv.Atoi(rec[6])
+		if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"invalid city ID %q in CSV line %d", rec[6], line)
+		}
+		state.Cities = append(state.Cities, &CityNode{City: City{Id: cityID, Code: rec[7], Name: rec[8]}, State: state})
+	}
+
+	tree.link()
+	tree.index()
+	return tree, nil
+}
+
+// Attach binds all places of the tree to the client, so that their methods,
+// e.g. [City.GetPrayerTimeDaily], can be used on places read from a snapshot.
+func (t *PlaceTree) Attach(client Client) {
+	for _, country := range t.Countries {
+		country.Country.client = client
+		for _, state := range country.States {
+			state.State.client = client
+			for _, city := range state.Cities {
+				city.City.client = client
+			}
+		}
+	}
+	t.link()
+}
+
+// link sets the parent back-references of the cities and states from the tree structure.
+func (t *PlaceTree) link() {
+	for _, country := range t.Countries {
+		for _, state := range country.States {
+			c := country.Country
+			state.State.country = &c
+			for _, city := range state.Cities {
+				s := state.State
+				city.City.state = &s
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package diyanet
+
+import (
+	"fmt"
+	"iter"
+	"time"
+)
+
+// PrayerName identifies one of the daily prayer times published by the Diyanet Awqat Salah API.
+type PrayerName int
+
+// The daily prayer times in chronological order.
+const (
+	Fajr PrayerName = iota
+	Sunrise
+	Dhuhr
+	Asr
+	Maghrib
+	Isha
+)
+
+// PrayerNames lists all prayer names in chronological order.
+var PrayerNames = [...]PrayerName{Fajr, Sunrise, Dhuhr, Asr, Maghrib, Isha}
+
+var prayerNameStrings = [...]string{"Fajr", "Sunrise", "Dhuhr", "Asr", "Maghrib", "Isha"}
+
+// String returns the English name of the prayer time, e.g. "Fajr".
+func (n PrayerName) String() string {
+	if n < 0 || int(n) >= len(prayerNameStrings) {
+		return fmt.Sprintf("PrayerName(%d)", int(n))
+	}
+	return prayerNameStrings[n]
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (n PrayerName) MarshalText() ([]byte, error) {
+	if n < 0 || int(n) >= len(prayerNameStrings) {
+		return nil, fmt.Errorf(errorPrefix+"invalid prayer name %d", int(n))
+	}
+	return []byte(prayerNameStrings[n]), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (n *PrayerName) UnmarshalText(text []byte) error {
+	name, err := ParsePrayerName(string(text))
+	if err != nil {
+		return err
+	}
+	*n = name
+	return nil
+}
+
+// ParsePrayerName returns the prayer name for its English name as returned by [PrayerName.String].
+func ParsePrayerName(s string) (PrayerName, error) {
+	for i, name := range prayerNameStrings {
+		if name == s {
+			return PrayerName(i), nil
+		}
+	}
+	return 0, fmt.Errorf(errorPrefix+"unknown prayer name %q", s)
+}
+
+// Clock returns the raw "HH:MM" clock time of the given prayer as returned by the API.
+func (pt PrayerTime) Clock(name PrayerName) string {
+	switch name {
+	case Fajr:
+		return pt.Fajr
+	case Sunrise:
+		return pt.Sunrise
+	case Dhuhr:
+		return pt.Dhuhr
+	case Asr:
+		return pt.Asr
+	case Maghrib:
+		return pt.Maghrib
+	case Isha:
+		return pt.Isha
+	}
+	return ""
+}
+
+// Day returns the prayer times of the day keyed by prayer name.
+func (pt PrayerTime) Day() (PrayerDay, error) {
+	prayers, err := pt.Prayers()
+	if err != nil {
+		return PrayerDay{}, err
+	}
+
+	day := PrayerDay{
+		Date:  pt.GregorianDate,
+		Times: make(map[PrayerName]time.Time, len(prayers)),
+	}
+	for _, p := range prayers {
+		day.Times[p.Name] = p.Time
+	}
+
+	return day, nil
+}
+
+// At returns the instant the given prayer begins. See [PrayerTime.Prayers] for how clock times are interpreted.
+func (pt PrayerTime) At(name PrayerName) (time.Time, error) {
+	day, err := pt.Day()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t, ok := day.Times[name]
+	if !ok {
+		return time.Time{}, fmt.Errorf(errorPrefix+"invalid prayer name %d", int(name))
+	}
+	return t, nil
+}
+
+// PrayerDay holds the prayer times of a single day keyed by prayer name.
+type PrayerDay struct {
+	// Date is the Gregorian date of the day.
+	Date time.Time
+	// Times maps each prayer to the instant it begins (the adhan time).
+	Times map[PrayerName]time.Time
+	// Iqamah maps prayers to their congregation time, if configured via [IqamahConfig].
+	Iqamah map[PrayerName]time.Time
+}
+
+// All returns an iterator over the prayer times of the day in chronological order.
+func (d PrayerDay) All() iter.Seq2[PrayerName, time.Time] {
+	return func(yield func(PrayerName, time.Time) bool) {
+		for _, name := range PrayerNames {
+			t, ok := d.Times[name]
+			if !ok {
+				continue
+			}
+			if !yield(name, t) {
+				return
+			}
+		}
+	}
+}
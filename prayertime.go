@@ -3,6 +3,7 @@ package diyanet
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"time"
 )
 
@@ -47,6 +48,8 @@ type PrayerTime struct {
 	GregorianDate time.Time `json:"gregorianDateLongIso8601"`
 	// GreenwichMeanTimeZone is the GMT offset for the location.
 	GreenwichMeanTimeZone float32
+	// CityId is the ID of the city the prayer times were retrieved for.
+	CityId int
 }
 
 func (pt *PrayerTime) fixGregorianDate(timezone *time.Location) {
@@ -63,21 +66,37 @@ func (pt *PrayerTime) fixGregorianDate(timezone *time.Location) {
 	)
 }
 
+// timezone returns the given timezone or, if nil, the zone resolved for the city via the client's resolver.
+// It returns nil, selecting the fixed GMT offset zone, if neither is available.
+func (c City) timezone(timezone *time.Location) *time.Location {
+	if timezone != nil || c.client.timezones == nil {
+		return timezone
+	}
+
+	loc, err := c.ResolveTimezone(*c.client.timezones)
+	if err != nil {
+		log.Printf("%s; falling back to the GMT offset provided by the API", err)
+		return nil
+	}
+	return loc
+}
+
 // GetPrayerTimeDaily retrieves the daily prayer times for a given city ID from the Diyanet Awqat Salah API.
 // If a timezone is provided, the GregorianDate field will be adjusted to that timezone.
-// If timezone is nil, the GregorianDate will be set to a fixed zone based on the GMT offset provided by the API.
+// If timezone is nil, the GregorianDate will be set to the zone resolved via [Config.Timezones] or,
+// if none is configured, to a fixed zone based on the GMT offset provided by the API.
 func (c City) GetPrayerTimeDaily(timezone *time.Location) ([]PrayerTime, error) {
+	timezone = c.timezone(timezone)
 	url := fmt.Sprintf(apiURLPrayerTimeDaily, c.Id)
-	resp, err := c.client.get(url)
+	body, err := c.client.get(url)
 	if err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to get daily prayer time for city %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
 	}
-	defer resp.Body.Close()
 
 	var result Result[[]PrayerTime]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to decode daily prayer time response for city %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
@@ -90,6 +109,7 @@ func (c City) GetPrayerTimeDaily(timezone *time.Location) ([]PrayerTime, error)
 
 	for i := range result.Data {
 		result.Data[i].fixGregorianDate(timezone)
+		result.Data[i].CityId = c.Id
 	}
 
 	return result.Data, nil
@@ -97,19 +117,20 @@ func (c City) GetPrayerTimeDaily(timezone *time.Location) ([]PrayerTime, error)
 
 // GetPrayerTimeWeekly retrieves the weekly prayer times for a given city ID from the Diyanet Awqat Salah API.
 // If a timezone is provided, the GregorianDate field will be adjusted to that timezone.
-// If timezone is nil, the GregorianDate will be set to a fixed zone based on the GMT offset provided by the API.
+// If timezone is nil, the GregorianDate will be set to the zone resolved via [Config.Timezones] or,
+// if none is configured, to a fixed zone based on the GMT offset provided by the API.
 func (c City) GetPrayerTimeWeekly(timezone *time.Location) ([]PrayerTime, error) {
+	timezone = c.timezone(timezone)
 	url := fmt.Sprintf(apiURLPrayerTimeWeekly, c.Id)
-	resp, err := c.client.get(url)
+	body, err := c.client.get(url)
 	if err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to get weekly prayer time for city %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
 	}
-	defer resp.Body.Close()
 
 	var result Result[[]PrayerTime]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to decode weekly prayer time response for city %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
@@ -122,6 +143,7 @@ func (c City) GetPrayerTimeWeekly(timezone *time.Location) ([]PrayerTime, error)
 
 	for i := range result.Data {
 		result.Data[i].fixGregorianDate(timezone)
+		result.Data[i].CityId = c.Id
 	}
 
 	return result.Data, nil
@@ -129,19 +151,20 @@ func (c City) GetPrayerTimeWeekly(timezone *time.Location) ([]PrayerTime, error)
 
 // GetPrayerTimeMonthly retrieves the monthly prayer times for a given city ID from the Diyanet Awqat Salah API.
 // If a timezone is provided, the GregorianDate field will be adjusted to that timezone.
-// If timezone is nil, the GregorianDate will be set to a fixed zone based on the GMT offset provided by the API.
+// If timezone is nil, the GregorianDate will be set to the zone resolved via [Config.Timezones] or,
+// if none is configured, to a fixed zone based on the GMT offset provided by the API.
 func (c City) GetPrayerTimeMonthly(timezone *time.Location) ([]PrayerTime, error) {
+	timezone = c.timezone(timezone)
 	url := fmt.Sprintf(apiURLPrayerTimeMonthly, c.Id)
-	resp, err := c.client.get(url)
+	body, err := c.client.get(url)
 	if err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to get monthly prayer time for city %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
 	}
-	defer resp.Body.Close()
 
 	var result Result[[]PrayerTime]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to decode monthly prayer time response for city %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
@@ -154,6 +177,7 @@ func (c City) GetPrayerTimeMonthly(timezone *time.Location) ([]PrayerTime, error
 
 	for i := range result.Data {
 		result.Data[i].fixGregorianDate(timezone)
+		result.Data[i].CityId = c.Id
 	}
 
 	return result.Data, nil
@@ -161,19 +185,20 @@ func (c City) GetPrayerTimeMonthly(timezone *time.Location) ([]PrayerTime, error
 
 // GetPrayerTimeRamadan retrieves the Ramadan prayer times for a given city ID from the Diyanet Awqat Salah API.
 // If a timezone is provided, the GregorianDate field will be adjusted to that timezone.
-// If timezone is nil, the GregorianDate will be set to a fixed zone based on the GMT offset provided by the API.
+// If timezone is nil, the GregorianDate will be set to the zone resolved via [Config.Timezones] or,
+// if none is configured, to a fixed zone based on the GMT offset provided by the API.
 func (c City) GetPrayerTimeRamadan(timezone *time.Location) ([]PrayerTime, error) {
+	timezone = c.timezone(timezone)
 	url := fmt.Sprintf(apiURLPrayerTimeRamadan, c.Id)
-	resp, err := c.client.get(url)
+	body, err := c.client.get(url)
 	if err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to get Ramadan prayer time for city %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
 	}
-	defer resp.Body.Close()
 
 	var result Result[[]PrayerTime]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to decode Ramadan prayer time response for city %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
@@ -186,7 +211,47 @@ func (c City) GetPrayerTimeRamadan(timezone *time.Location) ([]PrayerTime, error
 
 	for i := range result.Data {
 		result.Data[i].fixGregorianDate(timezone)
+		result.Data[i].CityId = c.Id
 	}
 
 	return result.Data, nil
 }
+
+// Prayer is a named prayer time of a day together with the instant it begins.
+type Prayer struct {
+	// Name is the name of the prayer time.
+	Name PrayerName
+	// Time is the instant the prayer time begins.
+	Time time.Time
+}
+
+// Prayers returns the Fajr, Sunrise, Dhuhr, Asr, Maghrib and Isha times of the day in chronological order.
+// The clock times are interpreted on GregorianDate in its location, so with an IANA zone every time
+// gets the offset valid at that instant, even across DST transitions. A time earlier than its predecessor
+// (e.g. Isha after midnight at high latitudes) is moved to the following day.
+func (pt PrayerTime) Prayers() ([]Prayer, error) {
+	prayers := make([]Prayer, 0, len(PrayerNames))
+	for _, name := range PrayerNames {
+		t, err := parseClock(pt.GregorianDate, pt.Clock(name))
+		if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"invalid %s time on %s: %w",
+				name, pt.GregorianDate.Format(time.DateOnly), err)
+		}
+		if len(prayers) > 0 && t.Before(prayers[len(prayers)-1].Time) {
+			t = t.AddDate(0, 0, 1)
+		}
+		prayers = append(prayers, Prayer{Name: name, Time: t})
+	}
+
+	return prayers, nil
+}
+
+// parseClock returns the instant of the "HH:MM" clock time on the day of date, in date's location.
+func parseClock(date time.Time, clock string) (time.Time, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), 0, 0, date.Location()), nil
+}
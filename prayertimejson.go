@@ -0,0 +1,104 @@
+package diyanet
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// prayerTimeJSON is the stable JSON schema of [PrayerTime]. It uses the field names of the API,
+// so API responses decode into it as well, and adds the normalized prayer instants and the time zone.
+type prayerTimeJSON struct {
+	ShapeMoonURL          string                   `json:"shapeMoonUrl"`
+	Fajr                  string                   `json:"fajr"`
+	Sunrise               string                   `json:"sunrise"`
+	Dhuhr                 string                   `json:"dhuhr"`
+	Asr                   string                   `json:"asr"`
+	Maghrib               string                   `json:"maghrib"`
+	Isha                  string                   `json:"isha"`
+	AstronomicalSunset    string                   `json:"astronomicalSunset"`
+	AstronomicalSunrise   string                   `json:"astronomicalSunrise"`
+	HijriDateShort        string                   `json:"hijriDateShort"`
+	HijriDateLong         string                   `json:"hijriDateLong"`
+	HijriDate             time.Time                `json:"hijriDateLongIso8601"`
+	QiblaTime             string                   `json:"qiblaTime"`
+	GregorianDateShort    string                   `json:"gregorianDateShort"`
+	GregorianDateLong     string                   `json:"gregorianDateLong"`
+	GregorianDate         time.Time                `json:"gregorianDateLongIso8601"`
+	GreenwichMeanTimeZone float32                  `json:"greenwichMeanTimeZone"`
+	CityId                int                      `json:"cityId,omitempty"`
+	TimeZone              string                   `json:"timeZone,omitempty"`
+	Times                 map[PrayerName]time.Time `json:"times,omitempty"`
+}
+
+// MarshalJSON implements [json.Marshaler]. Besides the raw fields as returned by the API,
+// the output contains the name of GregorianDate's time zone and the instant of every prayer,
+// so that the value round-trips through caches, exports and proxies without losing its normalization.
+func (pt PrayerTime) MarshalJSON() ([]byte, error) {
+	out := prayerTimeJSON{
+		ShapeMoonURL:          pt.ShapeMoonURL,
+		Fajr:                  pt.Fajr,
+		Sunrise:               pt.Sunrise,
+		Dhuhr:                 pt.Dhuhr,
+		Asr:                   pt.Asr,
+		Maghrib:               pt.Maghrib,
+		Isha:                  pt.Isha,
+		AstronomicalSunset:    pt.AstronomicalSunset,
+		AstronomicalSunrise:   pt.AstronomicalSunrise,
+		HijriDateShort:        pt.HijriDateShort,
+		HijriDateLong:         pt.HijriDateLong,
+		HijriDate:             pt.HijriDate,
+		QiblaTime:             pt.QiblaTime,
+		GregorianDateShort:    pt.GregorianDateShort,
+		GregorianDateLong:     pt.GregorianDateLong,
+		GregorianDate:         pt.GregorianDate,
+		GreenwichMeanTimeZone: pt.GreenwichMeanTimeZone,
+		CityId:                pt.CityId,
+		TimeZone:              pt.GregorianDate.Location().String(),
+	}
+	if day, err := pt.Day(); err == nil {
+		out.Times = day.Times
+	}
+
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler]. It accepts both API responses and the output of
+// [PrayerTime.MarshalJSON]; in the latter case GregorianDate is restored in its original time zone.
+func (pt *PrayerTime) UnmarshalJSON(data []byte) error {
+	var in prayerTimeJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	*pt = PrayerTime{
+		ShapeMoonURL:          in.ShapeMoonURL,
+		Fajr:                  in.Fajr,
+		Sunrise:               in.Sunrise,
+		Dhuhr:                 in.Dhuhr,
+		Asr:                   in.Asr,
+		Maghrib:               in.Maghrib,
+		Isha:                  in.Isha,
+		AstronomicalSunset:    in.AstronomicalSunset,
+		AstronomicalSunrise:   in.AstronomicalSunrise,
+		HijriDateShort:        in.HijriDateShort,
+		HijriDateLong:         in.HijriDateLong,
+		HijriDate:             in.HijriDate,
+		QiblaTime:             in.QiblaTime,
+		GregorianDateShort:    in.GregorianDateShort,
+		GregorianDateLong:     in.GregorianDateLong,
+		GregorianDate:         in.GregorianDate,
+		GreenwichMeanTimeZone: in.GreenwichMeanTimeZone,
+		CityId:                in.CityId,
+	}
+
+	if in.TimeZone != "" {
+		loc, err := time.LoadLocation(in.TimeZone)
+		if err != nil {
+			_, offset := in.GregorianDate.Zone()
+			loc = time.FixedZone(in.TimeZone, offset)
+		}
+		pt.GregorianDate = pt.GregorianDate.In(loc)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package diyanet
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Number of days, starting today, served by the daily, weekly and monthly endpoints.
+const (
+	dailyDays   = 1
+	weeklyDays  = 7
+	monthlyDays = 30
+)
+
+// MaxPrayerTimeRangeDays is the number of days, starting today, whose prayer times are served by the API
+// and can be requested with [City.GetPrayerTimeRange].
+const MaxPrayerTimeRangeDays = monthlyDays
+
+// ErrInvalidPrayerTimeRange is returned by [City.ParsePrayerTimeRange] for malformed dates and ranges
+// that are unordered or not served by the API.
+var ErrInvalidPrayerTimeRange = errors.New(errorPrefix + "invalid prayer time range")
+
+// GetPrayerTimeRange retrieves the prayer times for every day from start to end (both inclusive)
+// from the Diyanet Awqat Salah API, using the smallest of the daily, weekly and monthly endpoints
+// that covers the range, or the monthly endpoint whenever monthly prayer times are cached
+// (see [Config.PrayerTimeCacheTTL]). Only the dates of start and end are considered.
+//
+// The API only serves windows beginning today, so the range must not start before today
+// and must lie within the monthly window. "Today" is determined in timezone or, if timezone is nil,
+// in the location of start. See [City.GetPrayerTimeDaily] for the meaning of timezone.
+func (c City) GetPrayerTimeRange(start, end time.Time, timezone *time.Location) ([]PrayerTime, error) {
+	loc := timezone
+	if loc == nil {
+		loc = start.Location()
+	}
+
+	first := daysBetween(time.Now().In(loc), start)
+	last := daysBetween(time.Now().In(loc), end)
+	switch {
+	case last < first:
+		return nil, fmt.Errorf(errorPrefix+"invalid prayer time range for city %s (%d – %s): %s is after %s",
+			c.Name, c.Id, c.Code, start.Format(time.DateOnly), end.Format(time.DateOnly))
+	case first < 0 || last >= monthlyDays:
+		return nil, fmt.Errorf(errorPrefix+"prayer time range %s – %s for city %s (%d – %s) is outside the next %d days served by the API",
+			start.Format(time.DateOnly), end.Format(time.DateOnly), c.Name, c.Id, c.Code, monthlyDays)
+	}
+
+	var times []PrayerTime
+	var err error
+	switch {
+	case c.client.cacheTTL(fmt.Sprintf(apiURLPrayerTimeMonthly, c.Id)) > 0:
+		times, err = c.GetPrayerTimeMonthly(timezone)
+	case last < dailyDays:
+		times, err = c.GetPrayerTimeDaily(timezone)
+	case last < weeklyDays:
+		times, err = c.GetPrayerTimeWeekly(timezone)
+	default:
+		times, err = c.GetPrayerTimeMonthly(timezone)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	byDate := make(map[string]PrayerTime, len(times))
+	for _, pt := range times {
+		byDate[dateKey(pt.GregorianDate)] = pt
+	}
+
+	result := make([]PrayerTime, 0, last-first+1)
+	for day := start; daysBetween(day, end) >= 0; day = day.AddDate(0, 0, 1) {
+		pt, ok := byDate[dateKey(day)]
+		if !ok {
+			return nil, fmt.Errorf(errorPrefix+"no prayer time for %s in API response for city %s (%d – %s)",
+				day.Format(time.DateOnly), c.Name, c.Id, c.Code)
+		}
+		result = append(result, pt)
+	}
+
+	return result, nil
+}
+
+// ParsePrayerTimeRange parses the first and last day of a request for the prayer times of the city, formatted
+// as "YYYY-MM-DD", for [City.GetPrayerTimeRange]. An empty from selects today and an empty to selects from.
+// The dates are taken in timezone or, if timezone is nil, in the time zone of the city: the zone resolved via
+// [Config.Timezones] or else the fixed zone of the GMT offset reported by the API, so that "today" is the
+// city's today rather than the server's. Errors for invalid input wrap [ErrInvalidPrayerTimeRange]; the range
+// must be ordered and lie within the next [MaxPrayerTimeRangeDays] days.
+func (c City) ParsePrayerTimeRange(from, to string, timezone *time.Location) (start, end time.Time, err error) {
+	loc, err := c.location(timezone)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	start = today
+	if from != "" {
+		if start, err = time.ParseInLocation(time.DateOnly, from, loc); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: invalid date %q, expected YYYY-MM-DD", ErrInvalidPrayerTimeRange, from)
+		}
+	}
+	end = start
+	if to != "" {
+		if end, err = time.ParseInLocation(time.DateOnly, to, loc); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: invalid date %q, expected YYYY-MM-DD", ErrInvalidPrayerTimeRange, to)
+		}
+	}
+
+	if end.Before(start) || start.Before(today) || !end.Before(today.AddDate(0, 0, MaxPrayerTimeRangeDays)) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %s – %s must be ordered and lie within the next %d days",
+			ErrInvalidPrayerTimeRange, start.Format(time.DateOnly), end.Format(time.DateOnly), MaxPrayerTimeRangeDays)
+	}
+	return start, end, nil
+}
+
+// location returns timezone or, if nil, the time zone of the city: the zone resolved via the client's
+// resolver or else the fixed zone of the GMT offset of the monthly prayer times, which are cached.
+func (c City) location(timezone *time.Location) (*time.Location, error) {
+	if timezone = c.timezone(timezone); timezone != nil {
+		return timezone, nil
+	}
+
+	times, err := c.GetPrayerTimeMonthly(nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(times) == 0 {
+		return nil, fmt.Errorf(errorPrefix+"no prayer times to determine the time zone of city %s (%d – %s)",
+			c.Name, c.Id, c.Code)
+	}
+	return times[0].GregorianDate.Location(), nil
+}
+
+// daysBetween returns the number of calendar days from the date of a to the date of b.
+func daysBetween(a, b time.Time) int {
+	da := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	db := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(db.Sub(da).Hours() / 24)
+}
+
+// dateKey returns the calendar date of t as "YYYY-MM-DD".
+func dateKey(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
+
+// GetPrayerTimeForDate retrieves the prayer times of a single date from the Diyanet Awqat Salah API.
+// It is served from the monthly endpoint, so the date must lie within the next 30 days.
+// See [City.GetPrayerTimeDaily] for the meaning of timezone.
+func (c City) GetPrayerTimeForDate(date time.Time, timezone *time.Location) (PrayerTime, error) {
+	times, err := c.GetPrayerTimeMonthly(timezone)
+	if err != nil {
+		return PrayerTime{}, err
+	}
+
+	for _, pt := range times {
+		if dateKey(pt.GregorianDate) == dateKey(date) {
+			return pt, nil
+		}
+	}
+
+	return PrayerTime{}, fmt.Errorf(errorPrefix+"no prayer time for %s in monthly prayer times for city %s (%d – %s)",
+		date.Format(time.DateOnly), c.Name, c.Id, c.Code)
+}
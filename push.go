@@ -0,0 +1,104 @@
+package diyanet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// DefaultNtfyURL is the public ntfy server.
+const DefaultNtfyURL = "https://ntfy.sh/"
+
+// NtfyNotifier sends notifications as push messages through an ntfy server, see https://docs.ntfy.sh/publish/.
+// Phones subscribe to the topic with the ntfy app; the server may be self-hosted.
+type NtfyNotifier struct {
+	// BaseURL is the URL of the ntfy server. Defaults to [DefaultNtfyURL].
+	BaseURL string
+	// Topic is the topic published to. On public servers, anyone knowing it can subscribe.
+	Topic string
+	// Token is an access token for servers with access control; empty for none.
+	Token string
+	// Priority is the message priority from 1 (min) to 5 (max); 0 uses the server's default.
+	Priority int
+	// Filter selects the notifications sent.
+	Filter NotificationFilter
+	// HTTPClient is the HTTP client used to make requests. Defaults to [http.DefaultClient].
+	HTTPClient *http.Client
+}
+
+// Send publishes the notification to the topic if the filter selects it.
+func (n NtfyNotifier) Send(ctx context.Context, notification Notification) error {
+	if !n.Filter.Matches(notification) {
+		return nil
+	}
+	if n.Topic == "" {
+		return errors.New(errorPrefix + "ntfy notifier without topic")
+	}
+
+	base := n.BaseURL
+	if base == "" {
+		base = DefaultNtfyURL
+	}
+	var header http.Header
+	if n.Token != "" {
+		header = http.Header{"Authorization": {"Bearer " + n.Token}}
+	}
+
+	payload := map[string]any{
+		"topic":   n.Topic,
+		"title":   notification.Title,
+		"message": notification.Text,
+		"tags":    pushTags(notification),
+	}
+	if n.Priority != 0 {
+		payload["priority"] = n.Priority
+	}
+	return postJSON(ctx, n.HTTPClient, "ntfy", strings.TrimSuffix(base, "/")+"/", header, payload, nil)
+}
+
+// GotifyNotifier sends notifications as push messages through a self-hosted Gotify server,
+// see https://gotify.net/docs/pushmsg.
+type GotifyNotifier struct {
+	// BaseURL is the URL of the Gotify server, e.g. "https://gotify.example.com/".
+	BaseURL string
+	// Token is the token of the application created for the notifier in Gotify.
+	Token string
+	// Priority is the message priority; Gotify clients notify for priorities of 4 and above by default.
+	Priority int
+	// Filter selects the notifications sent.
+	Filter NotificationFilter
+	// HTTPClient is the HTTP client used to make requests. Defaults to [http.DefaultClient].
+	HTTPClient *http.Client
+}
+
+// Send pushes the notification if the filter selects it.
+func (g GotifyNotifier) Send(ctx context.Context, notification Notification) error {
+	if !g.Filter.Matches(notification) {
+		return nil
+	}
+	if g.BaseURL == "" || g.Token == "" {
+		return errors.New(errorPrefix + "gotify notifier without server or token")
+	}
+
+	payload := map[string]any{
+		"title":    notification.Title,
+		"message":  notification.Text,
+		"priority": g.Priority,
+	}
+	return postJSON(ctx, g.HTTPClient, "gotify", strings.TrimSuffix(g.BaseURL, "/")+"/message",
+		http.Header{"X-Gotify-Key": {g.Token}}, payload, nil)
+}
+
+// pushTags returns the ntfy tags of the notification; tags naming an emoji are shown as such.
+func pushTags(n Notification) []string {
+	switch {
+	case n.Event != nil:
+		return []string{"mosque", strings.ToLower(n.Event.Prayer.Name.String())}
+	case n.DailyContent != nil:
+		return []string{"open_book"}
+	case n.Summary != nil:
+		return []string{"calendar"}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package diyanet
+
+import (
+	"fmt"
+	"time"
+)
+
+// QiblaAt returns the instant at which the sun stands in the direction of the Qibla.
+// It fails if the API provides no Qibla time for the day.
+func (pt PrayerTime) QiblaAt() (time.Time, error) {
+	t, err := parseClock(pt.GregorianDate, pt.QiblaTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errorPrefix+"invalid Qibla time on %s: %w",
+			pt.GregorianDate.Format(time.DateOnly), err)
+	}
+	return t, nil
+}
+
+// QiblaWindow returns the window from margin before to margin after the Qibla time,
+// during which the direction of the sun can be used to find the Qibla.
+func (pt PrayerTime) QiblaWindow(margin time.Duration) (Interval, error) {
+	t, err := pt.QiblaAt()
+	if err != nil {
+		return Interval{}, err
+	}
+	return Interval{Start: t.Add(-margin), End: t.Add(margin)}, nil
+}
@@ -0,0 +1,23 @@
+package diyanet
+
+import "time"
+
+// Sahur returns the end of sahur (imsak), which coincides with the beginning of Fajr.
+func (pt PrayerTime) Sahur() (time.Time, error) {
+	return pt.At(Fajr)
+}
+
+// Iftar returns the time of iftar, which coincides with the beginning of Maghrib.
+func (pt PrayerTime) Iftar() (time.Time, error) {
+	return pt.At(Maghrib)
+}
+
+// FastingDuration returns the duration of the fast from the end of sahur until iftar.
+func (pt PrayerTime) FastingDuration() (time.Duration, error) {
+	day, err := pt.Day()
+	if err != nil {
+		return 0, err
+	}
+
+	return day.Times[Maghrib].Sub(day.Times[Fajr]), nil
+}
@@ -0,0 +1,22 @@
+package diyanet
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// GetRaw returns the body of the response of the Diyanet Awqat Salah API to a GET request for the path,
+// e.g. "api/PrayerTime/Daily/9541", without decoding it. Responses of cached endpoints are served from and stored
+// in the cache like those of the typed methods. It serves mirrors of the API such as the diyanetproxy package.
+func (c Client) GetRaw(path string) ([]byte, error) {
+	if !strings.HasPrefix(path, "api/") || strings.ContainsAny(path, "?#\\") || strings.Contains(path, "..") {
+		return nil, errors.New(errorPrefix + "invalid API path " + path)
+	}
+
+	body, err := c.get(apiURLPrefix + path)
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to get %s: %w", path, err)
+	}
+	return body, nil
+}
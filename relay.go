@@ -0,0 +1,121 @@
+package diyanet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+)
+
+// PinWriter drives a digital output, e.g. a GPIO pin switching a relay or the power of an amplifier.
+// Implement it with the GPIO library of the platform or wrap a function in [PinWriterFunc].
+type PinWriter interface {
+	// WritePin switches the output on (high) or off (low).
+	WritePin(high bool) error
+}
+
+// PinWriterFunc is an adapter to allow the use of ordinary functions as [PinWriter].
+type PinWriterFunc func(high bool) error
+
+// WritePin implements [PinWriter].
+func (f PinWriterFunc) WritePin(high bool) error {
+	return f(high)
+}
+
+// RelayTrigger is an [AdhanTrigger] switching a relay, typically the power of the speaker system of a mosque,
+// around the adhan played by another trigger. Register it with [Scheduler.OnRelay] to switch the relay on
+// ahead of the prayer time, so that the amplifier has warmed up when the adhan starts.
+type RelayTrigger struct {
+	// Pin switches the relay.
+	Pin PinWriter
+	// ActiveLow inverts the output for relay boards switching on a low level.
+	ActiveLow bool
+	// Adhan, if set, plays the adhan while the relay is on.
+	Adhan AdhanTrigger
+	// Lead is how long before the prayer time [Scheduler.OnRelay] switches the relay on.
+	Lead time.Duration
+	// Hold is how long the relay stays on after the adhan has been played, or after the prayer time without Adhan.
+	Hold time.Duration
+	// Clock times Hold. If nil, [SystemClock] is used; [Scheduler.OnRelay] uses the scheduler's clock instead.
+	Clock Clock
+}
+
+// Play implements [AdhanTrigger]: it switches the relay on, plays the adhan, waits for Hold
+// and switches the relay off again, even if the adhan failed.
+func (t RelayTrigger) Play(prayer Prayer, city City) error {
+	return t.PlayContext(context.Background(), prayer, city)
+}
+
+// PlayContext is like [RelayTrigger.Play], but stops waiting for Hold and switches the relay off
+// as soon as ctx is done. The adhan itself is played to the end.
+func (t RelayTrigger) PlayContext(ctx context.Context, prayer Prayer, city City) error {
+	if err := t.write(true); err != nil {
+		return err
+	}
+
+	var err error
+	if t.Adhan != nil {
+		err = t.Adhan.Play(prayer, city)
+	}
+	clock := t.Clock
+	if clock == nil {
+		clock = SystemClock
+	}
+	select {
+	case <-ctx.Done():
+	case <-clock.After(t.Hold):
+	}
+
+	return errors.Join(err, t.write(false))
+}
+
+// write switches the relay on or off.
+func (t RelayTrigger) write(on bool) error {
+	if t.Pin == nil {
+		return errors.New(errorPrefix + "relay trigger without pin")
+	}
+	if err := t.Pin.WritePin(on != t.ActiveLow); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to switch relay: %w", err)
+	}
+	return nil
+}
+
+// OnRelay registers the relay trigger to switch on Lead before the given prayers, or all prayers but Sunrise
+// if none are given, play the adhan at the prayer time and switch off afterwards (see [RelayTrigger.Play]).
+// Like [Scheduler.OnAdhan], the trigger runs in its own goroutine, errors are logged and late events are skipped.
+// The relay is timed by the scheduler's clock, unless relay.Clock is set, and switched off when Run returns.
+func (s *Scheduler) OnRelay(relay RelayTrigger, prayers ...PrayerName) {
+	if len(prayers) == 0 {
+		prayers = []PrayerName{Fajr, Dhuhr, Asr, Maghrib, Isha}
+	}
+
+	s.OnReminder(Reminder{Offset: -max(relay.Lead, 0), Prayers: slices.Clone(prayers)}, func(event PrayerEvent) {
+		if event.Late {
+			return
+		}
+		go func() {
+			relay := relay
+			if relay.Clock == nil {
+				relay.Clock = s.clock()
+			}
+			if err := relay.write(true); err != nil {
+				log.Printf("%s; adhan not played", err)
+				return
+			}
+			ctx := s.runContext()
+			select {
+			case <-ctx.Done():
+				if err := relay.write(false); err != nil {
+					log.Print(err)
+				}
+				return
+			case <-relay.Clock.After(max(event.Prayer.Time.Sub(relay.Clock.Now()), 0)):
+			}
+			if err := relay.PlayContext(ctx, event.Prayer, event.City); err != nil {
+				log.Printf("%s; adhan not played", err)
+			}
+		}()
+	})
+}
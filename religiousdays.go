@@ -0,0 +1,150 @@
+package diyanet
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ReligiousDay identifies an Islamic special day or holy night.
+type ReligiousDay int
+
+// The special days recognized by [ReligiousDays].
+const (
+	// HijriNewYear is 1 Muharram.
+	HijriNewYear ReligiousDay = iota + 1
+	// Ashura is 10 Muharram.
+	Ashura
+	// MawlidKandil is the night before 12 Rabi al-Awwal.
+	MawlidKandil
+	// RegaibKandil is the night before the first Friday of Rajab.
+	RegaibKandil
+	// MiracKandil is the night before 27 Rajab.
+	MiracKandil
+	// BeratKandil is the night before 15 Shaban.
+	BeratKandil
+	// RamadanStart is 1 Ramadan.
+	RamadanStart
+	// LaylatAlQadr is the night before 27 Ramadan.
+	LaylatAlQadr
+	// EidAlFitrEve is the last day of Ramadan (arefe).
+	EidAlFitrEve
+	// EidAlFitr are the three days from 1 Shawwal.
+	EidAlFitr
+	// EidAlAdhaEve is 9 Dhu al-Hijjah, the day of Arafah.
+	EidAlAdhaEve
+	// EidAlAdha are the four days from 10 Dhu al-Hijjah.
+	EidAlAdha
+)
+
+var religiousDayStrings = [...]string{
+	HijriNewYear: "Hijri New Year",
+	Ashura:       "Ashura",
+	MawlidKandil: "Mawlid Kandil",
+	RegaibKandil: "Regaib Kandil",
+	MiracKandil:  "Miraç Kandil",
+	BeratKandil:  "Berat Kandil",
+	RamadanStart: "Start of Ramadan",
+	LaylatAlQadr: "Laylat al-Qadr",
+	EidAlFitrEve: "Eve of Eid al-Fitr",
+	EidAlFitr:    "Eid al-Fitr",
+	EidAlAdhaEve: "Day of Arafah",
+	EidAlAdha:    "Eid al-Adha",
+}
+
+// String returns the English name of the special day.
+func (d ReligiousDay) String() string {
+	if d <= 0 || int(d) >= len(religiousDayStrings) {
+		return fmt.Sprintf("ReligiousDay(%d)", int(d))
+	}
+	return religiousDayStrings[d]
+}
+
+// ReligiousEvent is an occurrence of a special day in a list of prayer times.
+type ReligiousEvent struct {
+	// Kind is the special day.
+	Kind ReligiousDay
+	// Date is the Gregorian date the event falls on.
+	Date time.Time
+	// Day is the 1-based day number of multi-day events such as the Eids, and 1 otherwise.
+	Day int
+	// Evening reports whether the observance begins on the evening of Date, as holy nights (kandils) do.
+	Evening bool
+}
+
+// Hijri month numbers.
+const (
+	muharram  = 1
+	rabiAwwal = 3
+	rajab     = 7
+	shaban    = 8
+	ramadan   = 9
+	shawwal   = 10
+	dhuHijjah = 12
+)
+
+// ReligiousDays returns the special days found in times, derived from their Hijri dates, in chronological order.
+// Events that depend on the following day, such as Regaib Kandil or the eve of Eid al-Fitr,
+// are only reported when that day is also part of times, except where the Hijri date alone decides them.
+func ReligiousDays(times []PrayerTime) []ReligiousEvent {
+	days := slices.Clone(times)
+	slices.SortFunc(days, func(a, b PrayerTime) int {
+		return a.GregorianDate.Compare(b.GregorianDate)
+	})
+
+	var events []ReligiousEvent
+	for i, pt := range days {
+		if pt.HijriDate.IsZero() {
+			continue
+		}
+		month, day := int(pt.HijriDate.Month()), pt.HijriDate.Day()
+
+		var next *PrayerTime
+		if i+1 < len(days) && daysBetween(pt.GregorianDate, days[i+1].GregorianDate) == 1 && !days[i+1].HijriDate.IsZero() {
+			next = &days[i+1]
+		}
+
+		add := func(kind ReligiousDay, n int, evening bool) {
+			events = append(events, ReligiousEvent{Kind: kind, Date: pt.GregorianDate, Day: n, Evening: evening})
+		}
+
+		switch {
+		case month == muharram && day == 1:
+			add(HijriNewYear, 1, false)
+		case month == muharram && day == 10:
+			add(Ashura, 1, false)
+		case month == rabiAwwal && day == 11:
+			add(MawlidKandil, 1, true)
+		case month == rajab && day == 26:
+			add(MiracKandil, 1, true)
+		case month == shaban && day == 14:
+			add(BeratKandil, 1, true)
+		case month == ramadan && day == 1:
+			add(RamadanStart, 1, false)
+		case month == ramadan && day == 26:
+			add(LaylatAlQadr, 1, true)
+		case month == shawwal && day <= 3:
+			add(EidAlFitr, day, false)
+		case month == dhuHijjah && day == 9:
+			add(EidAlAdhaEve, 1, false)
+		case month == dhuHijjah && day >= 10 && day <= 13:
+			add(EidAlAdha, day-9, false)
+		}
+
+		if month == ramadan && (day == 30 || next != nil && int(next.HijriDate.Month()) == shawwal) {
+			add(EidAlFitrEve, 1, false)
+		}
+
+		if pt.GregorianDate.Weekday() == time.Thursday {
+			if next != nil {
+				if int(next.HijriDate.Month()) == rajab && next.HijriDate.Day() <= 7 {
+					add(RegaibKandil, 1, true)
+				}
+			} else if month == rajab && day <= 6 {
+				add(RegaibKandil, 1, true)
+			}
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,86 @@
+package diyanet
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResolvePath retrieves the city identified by a human-readable country/state/city path
+// such as "Germany/Berlin/Berlin" or "TÜRKİYE/İSTANBUL/ÜSKÜDAR". The path may also be given as separate
+// segments, e.g. ResolvePath("Germany", "Berlin", "Berlin"). Names are compared after [NormalizePlaceName],
+// and countries additionally match by their code, English name or ISO 3166-1 code.
+func (c Client) ResolvePath(path ...string) (City, error) {
+	var segments []string
+	for _, p := range path {
+		for segment := range strings.SplitSeq(p, "/") {
+			if segment = strings.TrimSpace(segment); segment != "" {
+				segments = append(segments, segment)
+			}
+		}
+	}
+	if len(segments) != 3 {
+		return City{}, fmt.Errorf(errorPrefix+"invalid place path %q: want country/state/city",
+			strings.Join(path, "/"))
+	}
+
+	countries, err := c.GetCountries()
+	if err != nil {
+		return City{}, err
+	}
+	country, ok := findPlace(countries, segments[0], countryMatches)
+	if !ok {
+		return City{}, fmt.Errorf(errorPrefix+"country %s not found", segments[0])
+	}
+
+	states, err := country.GetStates()
+	if err != nil {
+		return City{}, err
+	}
+	state, ok := findPlace(states, segments[1], func(s State, name string) bool {
+		return s.Code == name || NormalizePlaceName(s.Name) == NormalizePlaceName(name)
+	})
+	if !ok {
+		return City{}, fmt.Errorf(errorPrefix+"state %s not found in country %s (%d – %s)",
+			segments[1], country.Name, country.Id, country.Code)
+	}
+
+	cities, err := state.GetCities()
+	if err != nil {
+		return City{}, err
+	}
+	city, ok := findPlace(cities, segments[2], func(c City, name string) bool {
+		return c.Code == name || NormalizePlaceName(c.Name) == NormalizePlaceName(name)
+	})
+	if !ok {
+		return City{}, fmt.Errorf(errorPrefix+"city %s not found in state %s (%d – %s)",
+			segments[2], state.Name, state.Id, state.Code)
+	}
+
+	return city, nil
+}
+
+// countryMatches reports whether name refers to the country by its code, its Turkish or English name,
+// or its ISO 3166-1 code.
+func countryMatches(country Country, name string) bool {
+	if country.Code == name || NormalizePlaceName(country.Name) == NormalizePlaceName(name) {
+		return true
+	}
+
+	iso, ok := country.ISO()
+	if !ok {
+		return false
+	}
+	code := strings.ToUpper(name)
+	return iso.Alpha2 == code || iso.Alpha3 == code || isoCountriesByName()[NormalizePlaceName(name)] == iso
+}
+
+// findPlace returns the first place that matches name.
+func findPlace[P any](places []P, name string, matches func(P, string) bool) (P, bool) {
+	for _, place := range places {
+		if matches(place, name) {
+			return place, true
+		}
+	}
+	var zero P
+	return zero, false
+}
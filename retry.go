@@ -0,0 +1,112 @@
+package diyanet
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests to the Diyanet Awqat Salah API are retried.
+// It applies to data requests as well as to login and token refresh requests.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// A value of 1 disables retries; zero selects [DefaultRetryPolicy].
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. It doubles after every attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0–1) of each delay that is randomized to avoid synchronized retries.
+	Jitter float64
+}
+
+// DefaultRetryPolicy is used when [Config.Retry] is left at its zero value.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Jitter:         0.2,
+}
+
+func (p RetryPolicy) normalized() RetryPolicy {
+	if p.MaxAttempts == 0 {
+		return DefaultRetryPolicy
+	}
+	if p.MaxAttempts < 1 {
+		p.MaxAttempts = 1
+	}
+	p.Jitter = min(max(p.Jitter, 0), 1)
+	return p
+}
+
+// backoff returns the delay before the given retry (1 for the first retry).
+func (p RetryPolicy) backoff(retry int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, max(p.MaxBackoff, p.InitialBackoff))
+		}
+	}
+
+	delay := p.InitialBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if p.Jitter > 0 && delay > 0 {
+		spread := float64(delay) * p.Jitter
+		delay = time.Duration(float64(delay) - spread + rand.Float64()*2*spread)
+	}
+	return delay
+}
+
+// do sends the request built by newRequest, retrying transient failures according to the policy.
+// newRequest is called once per attempt so that request bodies can be replayed.
+func (p RetryPolicy) do(
+	ctx context.Context,
+	client *http.Client,
+	newRequest func() (*http.Request, error)) (*http.Response, error) {
+	p = p.normalized()
+
+	for attempt := 1; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.Do(req)
+		if attempt >= p.MaxAttempts || !isRetryable(ctx, resp, err) {
+			return resp, err
+		}
+
+		delay := p.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func isRetryable(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		var tokenErr *tokenError
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) && !errors.As(err, &tokenErr)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
@@ -0,0 +1,575 @@
+package diyanet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// schedulerMaxSleep bounds how long the scheduler sleeps at once, so that clock jumps,
+	// drift and suspended systems are noticed within this time.
+	schedulerMaxSleep = time.Minute
+	// schedulerRefreshInterval is how often the scheduler refreshes the prayer times of its cities.
+	schedulerRefreshInterval = 24 * time.Hour
+	// schedulerRetryInterval is how long the scheduler waits before retrying a failed refresh.
+	schedulerRetryInterval = 15 * time.Minute
+	// schedulerRefreshTimeout bounds the request of the prayer times of a city during a refresh.
+	schedulerRefreshTimeout = time.Minute
+)
+
+// PrayerEvent is an event fired by a [Scheduler].
+type PrayerEvent struct {
+	// City is the city of the prayer.
+	City City
+	// Prayer is the prayer and its time.
+	Prayer Prayer
+	// Offset is the offset of the event from the prayer time, negative for reminders before the prayer.
+	Offset time.Duration
+	// Time is when the event is due, the prayer time plus Offset.
+	Time time.Time
+	// Late is set if the event is fired more than a minute after it was due,
+	// e.g. when caught up after a restart (see [Scheduler.CatchUp]).
+	Late bool
+}
+
+// Reminder selects the events a handler registered with [Scheduler.OnReminder] is called for.
+type Reminder struct {
+	// Offset is the offset from the prayer time, e.g. -20 * time.Minute for 20 minutes before the prayer.
+	Offset time.Duration
+	// Prayers restricts the reminder to the given prayers. If empty, it applies to all prayers.
+	Prayers []PrayerName
+	// Weekdays restricts the reminder to prayers on the given weekdays, e.g. time.Friday.
+	// If empty, it applies to every day.
+	Weekdays []time.Weekday
+}
+
+// matches reports whether the reminder applies to the prayer.
+func (r Reminder) matches(prayer Prayer) bool {
+	return (len(r.Prayers) == 0 || slices.Contains(r.Prayers, prayer.Name)) &&
+		(len(r.Weekdays) == 0 || slices.Contains(r.Weekdays, prayer.Time.Weekday()))
+}
+
+// Jumuah configures the Friday (Jumu'ah) prayer events of a [Scheduler], see [Scheduler.OnJumuah].
+type Jumuah struct {
+	// Time is the fixed local clock time of the Jumu'ah prayer in the format "15:04", e.g. "13:30".
+	// If empty, the Jumu'ah prayer is at Dhuhr plus Offset.
+	Time string
+	// Offset is the offset of the Jumu'ah prayer from Dhuhr, used if Time is empty.
+	Offset time.Duration
+	// Khutbah, if positive, additionally fires a khutbah reminder this long before the Jumu'ah prayer.
+	Khutbah time.Duration
+}
+
+// JumuahEvent is a Friday event fired by a [Scheduler].
+type JumuahEvent struct {
+	// City is the city of the prayer.
+	City City
+	// Dhuhr is the Dhuhr prayer of the Friday.
+	Dhuhr Prayer
+	// Jumuah is the time of the Jumu'ah prayer.
+	Jumuah time.Time
+	// Khutbah is set for the khutbah reminder before the Jumu'ah prayer.
+	Khutbah bool
+	// Time is when the event is due: Jumuah, or Jumuah minus [Jumuah.Khutbah] for the khutbah reminder.
+	Time time.Time
+	// Late is set if the event is fired more than a minute after it was due.
+	Late bool
+}
+
+// at returns the time of the Jumu'ah prayer on the Friday of the Dhuhr prayer.
+func (j Jumuah) at(dhuhr Prayer) time.Time {
+	if j.Time == "" {
+		return dhuhr.Time.Add(j.Offset)
+	}
+	t, _ := parseClock(dhuhr.Time, j.Time) // validated by OnJumuah
+	return t
+}
+
+// subscription is a handler registered with a scheduler.
+type subscription struct {
+	reminder Reminder
+	handler  func(PrayerEvent)
+
+	jumuah        *Jumuah
+	jumuahHandler func(JumuahEvent)
+}
+
+// scheduledEvent is an event due for a subscription.
+type scheduledEvent struct {
+	time time.Time
+	fire func(late bool)
+}
+
+// schedulerState is the content of [Scheduler.StateFile].
+type schedulerState struct {
+	// Fired is the due time of the last fired event.
+	Fired time.Time `json:"fired"`
+}
+
+// Scheduler fires registered handlers at the prayer times of one or more cities.
+// It retrieves the prayer times itself and refreshes them daily, so it can run indefinitely.
+// Prayer times are taken in the time zones resolved by the client (see [Config.Timezones]),
+// so DST transitions are handled; the scheduler wakes up at least once a minute to notice clock changes.
+type Scheduler struct {
+	// Clock is the clock driving the scheduler. If nil, [SystemClock] is used.
+	Clock Clock
+	// Store optionally persists the retrieved prayer times, so that the scheduler can keep running
+	// through API outages spanning restarts, for example an [SQLCache].
+	Store TimetableStore
+	// StateFile is the path of a file recording the last fired event, so that a restarted scheduler
+	// knows which events already fired. If empty, no state is kept.
+	StateFile string
+	// CatchUp makes Run fire the events of the current day missed while the scheduler was not running,
+	// with Late set, instead of skipping them. It requires StateFile and has no effect on the first run.
+	CatchUp bool
+
+	cities []City
+
+	mu            sync.Mutex
+	ctx           context.Context // of the running Run, for the handlers' goroutines
+	subscriptions []subscription
+	disabled      map[PrayerName]bool
+	timetables    map[int]Timetable
+	refreshed     map[int]time.Time
+
+	failures       map[int]error
+	degraded       bool
+	statusHandlers []func(SchedulerStatus)
+	startHandlers  []func()
+}
+
+// SchedulerStatus reports whether a [Scheduler] runs on up-to-date prayer times.
+type SchedulerStatus struct {
+	// Degraded is set if the prayer times of some city could not be refreshed,
+	// so the scheduler runs on previously retrieved or stored prayer times.
+	Degraded bool
+	// Err holds the errors of the failed refreshes, if degraded.
+	Err error
+	// DataUntil is the last day prayer times are available for all cities;
+	// zero if there are none for some city.
+	DataUntil time.Time
+}
+
+// NewScheduler returns a scheduler for the prayer times of the given cities, retrieved via client.
+func NewScheduler(client Client, cities ...City) *Scheduler {
+	s := &Scheduler{
+		cities:     slices.Clone(cities),
+		disabled:   make(map[PrayerName]bool),
+		timetables: make(map[int]Timetable),
+		refreshed:  make(map[int]time.Time),
+		failures:   make(map[int]error),
+	}
+	for i := range s.cities {
+		s.cities[i].client = client
+	}
+	return s
+}
+
+// OnPrayer registers a handler called at the time of every prayer.
+// Handlers are called one after another from the goroutine running [Scheduler.Run] and should return quickly.
+func (s *Scheduler) OnPrayer(handler func(PrayerEvent)) {
+	s.OnReminder(Reminder{}, handler)
+}
+
+// OnReminder registers a handler called at the given offset from the prayers selected by the reminder,
+// for example 45 minutes before Dhuhr on Fridays.
+// Handlers are called one after another from the goroutine running [Scheduler.Run] and should return quickly.
+func (s *Scheduler) OnReminder(reminder Reminder, handler func(PrayerEvent)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscriptions = append(s.subscriptions, subscription{reminder: reminder, handler: handler})
+}
+
+// OnJumuah registers a handler called on Fridays at the time of the Jumu'ah prayer and, if configured,
+// before the khutbah. Jumu'ah events are independent of [Scheduler.SetEnabled], so a Dhuhr handler can be
+// limited to the other days with [Reminder.Weekdays]. OnJumuah fails if the Jumu'ah time is invalid.
+// Handlers are called one after another from the goroutine running [Scheduler.Run] and should return quickly.
+func (s *Scheduler) OnJumuah(jumuah Jumuah, handler func(JumuahEvent)) error {
+	if jumuah.Time != "" {
+		if _, err := time.Parse("15:04", jumuah.Time); err != nil {
+			return fmt.Errorf(errorPrefix+"invalid Jumu'ah time %q: %w", jumuah.Time, err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscriptions = append(s.subscriptions, subscription{jumuah: &jumuah, jumuahHandler: handler})
+	return nil
+}
+
+// OnDailySummary registers a handler called every day the given duration before Fajr
+// with the prayer times of the day, e.g. to send a morning summary (see [NewSummaryNotification]).
+// Handlers are called one after another from the goroutine running [Scheduler.Run] and should return quickly.
+func (s *Scheduler) OnDailySummary(before time.Duration, handler func(city City, day PrayerTime)) {
+	s.OnReminder(Reminder{Offset: -before, Prayers: []PrayerName{Fajr}}, func(event PrayerEvent) {
+		s.mu.Lock()
+		day, ok := s.timetables[event.City.Id].ByDate(event.Prayer.Time)
+		s.mu.Unlock()
+		if ok {
+			handler(event.City, day)
+		}
+	})
+}
+
+// SetEnabled enables or disables all events of the given prayer. All prayers are enabled initially.
+func (s *Scheduler) SetEnabled(name PrayerName, enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.disabled[name] = !enabled
+}
+
+// OnStatus registers a handler called whenever the scheduler enters or leaves degraded mode.
+func (s *Scheduler) OnStatus(handler func(SchedulerStatus)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statusHandlers = append(s.statusHandlers, handler)
+}
+
+// Run fires the handlers until ctx is done and then returns ctx's error.
+// Events due while Run is not running are not fired, unless caught up (see [Scheduler.CatchUp]).
+//
+// If prayer times cannot be retrieved, Run logs the error, retries later and keeps firing events from the
+// prayer times retrieved before, entering degraded mode (see [Scheduler.OnStatus]). The scheduler holds its own
+// copy of the last retrieved month, so it runs through outages of several weeks without further configuration;
+// only the Store supplies prayer times after a restart during an outage. Run fails only if no prayer times of any city are available initially.
+// Later refreshes run in the background, so events keep firing on time while the API is slow.
+func (s *Scheduler) Run(ctx context.Context) error {
+	if len(s.cities) == 0 {
+		return errors.New(errorPrefix + "scheduler without cities")
+	}
+
+	s.mu.Lock()
+	s.ctx = ctx
+	s.mu.Unlock()
+
+	clock := s.clock()
+	if err := s.refresh(ctx, clock.Now()); err != nil && !s.hasPrayerTimes() {
+		return err
+	}
+
+	s.mu.Lock()
+	start := slices.Clone(s.startHandlers)
+	s.mu.Unlock()
+	for _, handler := range start {
+		handler()
+	}
+
+	since := clock.Now()
+	state, err := readSchedulerState(s.StateFile)
+	if err != nil {
+		return err
+	}
+	if s.CatchUp && !state.Fired.IsZero() {
+		y, m, d := since.Date()
+		since = time.Date(y, m, d, 0, 0, 0, 0, since.Location())
+	}
+	if state.Fired.After(since) {
+		since = state.Fired
+	}
+
+	var refreshed chan error
+	for {
+		if refreshed == nil && s.refreshDue(clock.Now()) {
+			refreshed = make(chan error, 1)
+			go func() {
+				refreshed <- s.refresh(ctx, clock.Now())
+			}()
+		}
+
+		now := clock.Now()
+		wait := schedulerMaxSleep
+		fired := state.Fired
+		for _, event := range s.schedule(since) {
+			if event.time.After(now) {
+				wait = min(wait, event.time.Sub(now))
+				break
+			}
+			event.fire(now.Sub(event.time) > schedulerMaxSleep)
+			state.Fired = event.time
+		}
+		if !state.Fired.Equal(fired) {
+			if err := writeSchedulerState(s.StateFile, state); err != nil {
+				log.Printf("%s; continuing without state", err)
+			}
+		}
+		if now.After(since) {
+			since = now
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-refreshed:
+			// The events are scheduled again from the refreshed prayer times.
+			refreshed = nil
+			if err != nil {
+				log.Printf("%s; retrying later", err)
+			}
+		case <-clock.After(max(wait, 0)):
+		}
+	}
+}
+
+// refreshDue reports whether the prayer times of any city are due for a refresh at now.
+func (s *Scheduler) refreshDue(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, city := range s.cities {
+		if now.Sub(s.refreshed[city.Id]) >= schedulerRefreshInterval {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPrayerTimes reports whether prayer times of any city are available.
+func (s *Scheduler) hasPrayerTimes() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range s.timetables {
+		if len(t) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// nextPrayer returns the first prayer of the city after now.
+func (s *Scheduler) nextPrayer(city City, now time.Time) (UpcomingPrayer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return NextPrayer(s.timetables[city.Id], now)
+}
+
+// runContext returns the context of the running [Scheduler.Run], or the background context before Run.
+func (s *Scheduler) runContext() context.Context {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ctx == nil {
+		return context.Background()
+	}
+	return s.ctx
+}
+
+func (s *Scheduler) clock() Clock {
+	if s.Clock == nil {
+		return SystemClock
+	}
+	return s.Clock
+}
+
+// refresh retrieves the prayer times of the cities not refreshed within the refresh interval
+// (or the retry interval after a failure) and drops the days before yesterday and the days that cannot be parsed.
+// If a city's prayer times cannot be retrieved, the held ones are kept, supplemented by stored ones.
+// The requests are made in ctx, each bounded by schedulerRefreshTimeout.
+func (s *Scheduler) refresh(ctx context.Context, now time.Time) error {
+	var errs []error
+	for _, city := range s.cities {
+		s.mu.Lock()
+		due := now.Sub(s.refreshed[city.Id]) >= schedulerRefreshInterval
+		s.mu.Unlock()
+		if !due {
+			continue
+		}
+
+		requestCtx, cancel := context.WithTimeout(ctx, schedulerRefreshTimeout)
+		city.client.ctx = requestCtx
+		times, err := city.GetPrayerTimeMonthly(nil)
+		cancel()
+		if err == nil && s.Store != nil {
+			if err := s.Store.StoreTimetable(ctx, NewTimetable(times)); err != nil {
+				log.Printf("%s; continuing without storing", err)
+			}
+		}
+		if err != nil {
+			errs = append(errs, err)
+			times = s.fallback(ctx, city, now)
+		}
+
+		s.mu.Lock()
+		if err != nil {
+			s.refreshed[city.Id] = now.Add(schedulerRetryInterval - schedulerRefreshInterval)
+			s.failures[city.Id] = err
+		} else {
+			s.refreshed[city.Id] = now
+			delete(s.failures, city.Id)
+		}
+		merged := s.timetables[city.Id].Merge(NewTimetable(times))
+		if _, last, ok := merged.Dates(); ok {
+			merged = slices.Clone(merged.Range(now.AddDate(0, 0, -1), last))
+		}
+		// Days whose prayer times cannot be parsed are dropped, so that they don't stop the other days' events.
+		merged = slices.DeleteFunc(merged, func(pt PrayerTime) bool {
+			_, err := pt.Prayers()
+			if err != nil {
+				log.Printf("%s; skipping prayer times of %s for city %s (%d – %s)",
+					err, pt.GregorianDate.Format(time.DateOnly), city.Name, city.Id, city.Code)
+			}
+			return err != nil
+		})
+		s.timetables[city.Id] = merged
+		s.mu.Unlock()
+	}
+
+	s.updateStatus()
+	return errors.Join(errs...)
+}
+
+// fallback returns the prayer times of the city from the store, if any. They are merged into the prayer times
+// held by the scheduler, which are kept in any case. The client's cache is not consulted, since it evicts the
+// monthly prayer times once they expire, which is about when a refresh is due.
+func (s *Scheduler) fallback(ctx context.Context, city City, now time.Time) []PrayerTime {
+	if s.Store != nil {
+		if times, err := s.Store.Timetable(ctx, city.Id, now.AddDate(0, 0, -1), now.AddDate(1, 0, 0)); err == nil {
+			return times
+		}
+	}
+	return nil
+}
+
+// updateStatus notifies the status handlers if the scheduler entered or left degraded mode.
+func (s *Scheduler) updateStatus() {
+	s.mu.Lock()
+	status := SchedulerStatus{Degraded: len(s.failures) > 0}
+	for _, err := range s.failures {
+		status.Err = errors.Join(status.Err, err)
+	}
+	for _, city := range s.cities {
+		if _, last, ok := s.timetables[city.Id].Dates(); !ok {
+			status.DataUntil = time.Time{}
+			break
+		} else if status.DataUntil.IsZero() || last.Before(status.DataUntil) {
+			status.DataUntil = last
+		}
+	}
+	changed := status.Degraded != s.degraded
+	s.degraded = status.Degraded
+	handlers := slices.Clone(s.statusHandlers)
+	s.mu.Unlock()
+
+	if changed {
+		for _, handler := range handlers {
+			handler(status)
+		}
+	}
+}
+
+// schedule returns the events due after from, in chronological order.
+func (s *Scheduler) schedule(from time.Time) []scheduledEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var events []scheduledEvent
+	for _, city := range s.cities {
+		prayers, err := sortedPrayers(s.timetables[city.Id])
+		if err != nil {
+			log.Printf("%s; skipping prayer times of city %s (%d – %s)", err, city.Name, city.Id, city.Code)
+			continue
+		}
+
+		for _, sub := range s.subscriptions {
+			if sub.jumuah != nil {
+				events = append(events, jumuahEvents(city, prayers, from, sub)...)
+				continue
+			}
+
+			offset := sub.reminder.Offset
+			for _, prayer := range prayers[firstPrayerAfter(prayers, from.Add(-offset)):] {
+				if s.disabled[prayer.Name] || !sub.reminder.matches(prayer) {
+					continue
+				}
+				event := PrayerEvent{City: city, Prayer: prayer, Offset: offset, Time: prayer.Time.Add(offset)}
+				events = append(events, scheduledEvent{
+					time: event.Time,
+					fire: func(late bool) {
+						event.Late = late
+						sub.handler(event)
+					},
+				})
+			}
+		}
+	}
+
+	slices.SortStableFunc(events, func(a, b scheduledEvent) int {
+		return a.time.Compare(b.time)
+	})
+	return events
+}
+
+// jumuahEvents returns the Jumu'ah events of the subscription due after from.
+func jumuahEvents(city City, prayers []Prayer, from time.Time, sub subscription) []scheduledEvent {
+	var events []scheduledEvent
+	for _, prayer := range prayers {
+		if prayer.Name != Dhuhr || prayer.Time.Weekday() != time.Friday {
+			continue
+		}
+
+		jumuah := sub.jumuah.at(prayer)
+		if sub.jumuah.Khutbah > 0 {
+			event := JumuahEvent{City: city, Dhuhr: prayer, Jumuah: jumuah, Khutbah: true, Time: jumuah.Add(-sub.jumuah.Khutbah)}
+			if event.Time.After(from) {
+				events = append(events, scheduledEvent{time: event.Time, fire: func(late bool) {
+					event.Late = late
+					sub.jumuahHandler(event)
+				}})
+			}
+		}
+		event := JumuahEvent{City: city, Dhuhr: prayer, Jumuah: jumuah, Time: jumuah}
+		if event.Time.After(from) {
+			events = append(events, scheduledEvent{time: event.Time, fire: func(late bool) {
+				event.Late = late
+				sub.jumuahHandler(event)
+			}})
+		}
+	}
+	return events
+}
+
+// readSchedulerState reads the state at path, returning an empty state if path is empty or does not exist.
+func readSchedulerState(path string) (schedulerState, error) {
+	if path == "" {
+		return schedulerState{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return schedulerState{}, nil
+	}
+	if err != nil {
+		return schedulerState{}, fmt.Errorf(errorPrefix+"unable to read scheduler state: %w", err)
+	}
+
+	var state schedulerState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return schedulerState{}, fmt.Errorf(errorPrefix+"unable to decode scheduler state: %w", err)
+	}
+	return state, nil
+}
+
+// writeSchedulerState replaces the state at path atomically, unless path is empty.
+func writeSchedulerState(path string, state schedulerState) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write scheduler state: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write scheduler state: %w", err)
+	}
+	return nil
+}
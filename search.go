@@ -0,0 +1,226 @@
+package diyanet
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Minimum similarity of a city name to a query for the city to be reported as a match.
+const minMatchScore = 0.6
+
+// defaultFindCityLimit is the number of matches returned by [Client.FindCity] if no limit is given.
+const defaultFindCityLimit = 10
+
+// FindCityOptions refines a search with [Client.FindCity] or [Client.FindPlace].
+type FindCityOptions struct {
+	// Country restricts the search to the country with the given code or name, if not empty.
+	Country string
+	// Limit is the maximum number of matches returned. Defaults to 10.
+	Limit int
+}
+
+// CityMatch is a city found by [Client.FindCity].
+type CityMatch struct {
+	// City is the matching city.
+	City City
+	// Score rates the match from 0 (unrelated) to 1 (exact match).
+	Score float64
+}
+
+// FindCity searches cities by name using fuzzy matching and returns the best matches, best first.
+// Queries may be misspelled or abbreviated, e.g. "berlin", "Kahramanmaras" or "K. Maraş".
+// Only the names of cities are matched; [Client.FindPlace] also matches countries and states.
+func (c Client) FindCity(query string, opts FindCityOptions) ([]CityMatch, error) {
+	var cities []City
+	if opts.Country == "" {
+		var err error
+		if cities, err = c.GetCities(); err != nil {
+			return nil, err
+		}
+	} else {
+		countries, err := c.GetCountries()
+		if err != nil {
+			return nil, err
+		}
+		if _, _, cities, err = placesIn(countries, opts.Country); err != nil {
+			return nil, err
+		}
+	}
+
+	var matches []CityMatch
+	for _, city := range cities {
+		if score := matchScore(query, city.Name); score >= minMatchScore {
+			matches = append(matches, CityMatch{City: city, Score: score})
+		}
+	}
+
+	return rankMatches(matches, opts.Limit), nil
+}
+
+// PlaceMatch is a country, state or city found by [Client.FindPlace].
+type PlaceMatch struct {
+	// Level is the level of the place in the hierarchy.
+	Level PlaceLevel
+	// Id is the unique identifier of the place at its level.
+	Id int
+	// Code is the code of the place.
+	Code string
+	// Name is the name of the place.
+	Name string
+	// Score rates the match from 0 (unrelated) to 1 (exact match).
+	Score float64
+}
+
+// FindPlace searches countries, states and cities by name like [Client.FindCity] and returns the best matches,
+// best first; on equal scores, cities precede states and states precede countries. With opts.Country, only that
+// country and its states and cities are searched.
+func (c Client) FindPlace(query string, opts FindCityOptions) ([]PlaceMatch, error) {
+	countries, err := c.GetCountries()
+	if err != nil {
+		return nil, err
+	}
+
+	var states []State
+	var cities []City
+	if opts.Country == "" {
+		if states, err = c.GetStates(); err != nil {
+			return nil, err
+		}
+		if cities, err = c.GetCities(); err != nil {
+			return nil, err
+		}
+	} else {
+		var country Country
+		if country, states, cities, err = placesIn(countries, opts.Country); err != nil {
+			return nil, err
+		}
+		countries = []Country{country}
+	}
+
+	var matches []PlaceMatch
+	add := func(level PlaceLevel, id int, code, name string) {
+		if score := matchScore(query, name); score >= minMatchScore {
+			matches = append(matches, PlaceMatch{Level: level, Id: id, Code: code, Name: name, Score: score})
+		}
+	}
+	for _, country := range countries {
+		add(LevelCountry, country.Id, country.Code, country.Name)
+	}
+	for _, state := range states {
+		add(LevelState, state.Id, state.Code, state.Name)
+	}
+	for _, city := range cities {
+		add(LevelCity, city.Id, city.Code, city.Name)
+	}
+
+	slices.SortStableFunc(matches, func(a, b PlaceMatch) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), cmp.Compare(b.Level, a.Level), strings.Compare(a.Name, b.Name))
+	})
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultFindCityLimit
+	}
+	return matches[:min(len(matches), limit)], nil
+}
+
+// placesIn returns the country with the given code or name among countries, with its states and cities.
+func placesIn(countries []Country, name string) (Country, []State, []City, error) {
+	idx := slices.IndexFunc(countries, func(country Country) bool {
+		return country.Code == name || NormalizePlaceName(country.Name) == NormalizePlaceName(name)
+	})
+	if idx < 0 {
+		return Country{}, nil, nil, fmt.Errorf(errorPrefix+"country %s not found", name)
+	}
+
+	states, err := countries[idx].GetStates()
+	if err != nil {
+		return Country{}, nil, nil, err
+	}
+	var cities []City
+	for _, state := range states {
+		stateCities, err := state.GetCities()
+		if err != nil {
+			return Country{}, nil, nil, err
+		}
+		cities = append(cities, stateCities...)
+	}
+	return countries[idx], states, cities, nil
+}
+
+// rankMatches sorts matches best first and truncates them to limit, or to the default limit if not positive.
+func rankMatches(matches []CityMatch, limit int) []CityMatch {
+	slices.SortStableFunc(matches, func(a, b CityMatch) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), strings.Compare(a.City.Name, b.City.Name))
+	})
+
+	if limit <= 0 {
+		limit = defaultFindCityLimit
+	}
+	return matches[:min(len(matches), limit)]
+}
+
+// matchScore rates how well name matches query, from 0 to 1.
+func matchScore(query, name string) float64 {
+	q, n := NormalizePlaceName(query), NormalizePlaceName(name)
+	if q == "" || n == "" {
+		return 0
+	}
+
+	qc, nc := strings.ReplaceAll(q, " ", ""), strings.ReplaceAll(n, " ", "")
+	switch {
+	case qc == nc:
+		return 1
+	case strings.HasPrefix(nc, qc):
+		return 0.9
+	case matchesAbbreviation(strings.Fields(q), nc):
+		return 0.85
+	case strings.Contains(nc, qc):
+		return 0.7
+	}
+
+	distance := levenshtein([]rune(qc), []rune(nc))
+	return 1 - float64(distance)/float64(max(len([]rune(qc)), len([]rune(nc))))
+}
+
+// matchesAbbreviation reports whether the compacted name starts with the first token
+// and contains the remaining tokens in order, so that "k maras" matches "kahramanmaras".
+func matchesAbbreviation(tokens []string, name string) bool {
+	if len(tokens) < 2 || !strings.HasPrefix(name, tokens[0]) {
+		return false
+	}
+
+	rest := name[len(tokens[0]):]
+	for _, token := range tokens[1:] {
+		i := strings.Index(rest, token)
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+len(token):]
+	}
+	return true
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,125 @@
+package diyanet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// DefaultSlackURL is the URL of the Slack Web API.
+const DefaultSlackURL = "https://slack.com/api/"
+
+// Limits of Slack blocks in characters.
+const (
+	slackMaxHeader  = 150
+	slackMaxSection = 3000
+)
+
+// SlackNotifier posts notifications to Slack channels, through incoming webhooks or the Web API
+// method chat.postMessage, see https://api.slack.com/messaging/sending.
+type SlackNotifier struct {
+	// Channels are the channels notifications are posted to, each with its own preferences.
+	Channels []SlackChannel
+	// Token is the bot token ("xoxb-…") used for channels without a webhook.
+	// The bot needs the chat:write scope and must be a member of the channels.
+	Token string
+	// BaseURL is the URL of the Web API. Defaults to [DefaultSlackURL].
+	BaseURL string
+	// HTTPClient is the HTTP client used to make requests. Defaults to [http.DefaultClient].
+	HTTPClient *http.Client
+}
+
+// SlackChannel is a channel a [SlackNotifier] posts to.
+type SlackChannel struct {
+	// WebhookURL is the URL of an incoming webhook of the channel. It must be kept secret.
+	// If empty, the message is posted with the Web API to Channel.
+	WebhookURL string
+	// Channel is the ID or name of the channel for the Web API, e.g. "C0123456789" or "#prayer-times".
+	Channel string
+	// Filter selects the notifications posted to the channel.
+	Filter NotificationFilter
+}
+
+// slackResponse is the envelope of a Web API response.
+type slackResponse struct {
+	Ok    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+// slackEscaper escapes the control characters of Slack's mrkdwn format.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// Send posts the notification to every channel whose filter selects it. The message consists of
+// a header with the title and the text; summaries list the prayer times as fields.
+// Failed channels do not prevent posting to the others; their errors are returned joined.
+func (s SlackNotifier) Send(ctx context.Context, n Notification) error {
+	blocks := []map[string]any{{
+		"type": "header",
+		"text": map[string]any{"type": "plain_text", "text": truncate(n.Title, slackMaxHeader)},
+	}}
+	if n.Summary != nil {
+		var fields []map[string]any
+		for _, name := range PrayerNames {
+			fields = append(fields, map[string]any{
+				"type": "mrkdwn",
+				"text": "*" + name.String() + "*\n" + slackEscaper.Replace(n.Summary.Clock(name)),
+			})
+		}
+		blocks = append(blocks, map[string]any{"type": "section", "fields": fields})
+	} else if n.Text != "" {
+		blocks = append(blocks, map[string]any{
+			"type": "section",
+			"text": map[string]any{"type": "mrkdwn", "text": slackEscaper.Replace(truncate(n.Text, slackMaxSection))},
+		})
+	}
+
+	var errs []error
+	for _, channel := range s.Channels {
+		if !channel.Filter.Matches(n) {
+			continue
+		}
+		// The text is the fallback for notifications.
+		payload := map[string]any{"text": n.Title, "blocks": blocks}
+		if err := s.post(ctx, channel, payload); err != nil {
+			errs = append(errs, fmt.Errorf("%w (channel %s)", err, channel.name()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// post posts the message to the channel's webhook or with the Web API.
+func (s SlackNotifier) post(ctx context.Context, channel SlackChannel, payload map[string]any) error {
+	if channel.WebhookURL != "" {
+		return postJSON(ctx, s.HTTPClient, "slack", channel.WebhookURL, nil, payload, nil)
+	}
+	if s.Token == "" {
+		return errors.New(errorPrefix + "slack channel without webhook or token")
+	}
+
+	base := s.BaseURL
+	if base == "" {
+		base = DefaultSlackURL
+	}
+	payload["channel"] = channel.Channel
+
+	var resp slackResponse
+	err := postJSON(ctx, s.HTTPClient, "slack", strings.TrimSuffix(base, "/")+"/chat.postMessage",
+		http.Header{"Authorization": {"Bearer " + s.Token}}, payload, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return errors.New(errorPrefix + "slack request failed: " + resp.Error)
+	}
+	return nil
+}
+
+// name identifies the channel in errors without revealing the webhook URL.
+func (c SlackChannel) name() string {
+	if c.Channel != "" {
+		return c.Channel
+	}
+	return "with webhook"
+}
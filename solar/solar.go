@@ -0,0 +1,140 @@
+// Package solar provides the solar calculations underlying prayer times:
+// solar noon, sunrise and sunset, and the times at which the sun reaches arbitrary altitudes,
+// such as the twilight angles used for Fajr and Isha.
+//
+// The calculations follow the NOAA solar calculator and are accurate to about a minute
+// for latitudes between the polar circles.
+package solar
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// SunriseAltitude is the altitude of the sun's center at sunrise and sunset in degrees,
+// accounting for atmospheric refraction and the apparent radius of the sun.
+const SunriseAltitude = -0.833
+
+// ErrNoEvent is returned when the sun does not reach the requested altitude on the given day,
+// e.g. during polar day or polar night, or when twilight lasts all night.
+var ErrNoEvent = errors.New("solar: the sun does not reach the requested altitude on this day")
+
+// Declination returns the declination of the sun at t in degrees.
+func Declination(t time.Time) float64 {
+	decl, _ := position(t)
+	return decl
+}
+
+// EquationOfTime returns the difference between apparent and mean solar time at t.
+func EquationOfTime(t time.Time) time.Duration {
+	_, eqTime := position(t)
+	return minutes(eqTime)
+}
+
+// Noon returns the time of solar noon, when the sun crosses the meridian, on the date of date
+// at the given longitude. The result is in date's location.
+func Noon(date time.Time, longitude float64) time.Time {
+	midnight := utcMidnight(date)
+	noon := midnight.Add(12 * time.Hour)
+	for range 2 {
+		_, eqTime := position(noon)
+		noon = midnight.Add(minutes(720 - 4*longitude - eqTime))
+	}
+	return noon.In(date.Location())
+}
+
+// Sunrise returns the time of sunrise on the date of date at the given coordinates, in date's location.
+func Sunrise(date time.Time, latitude, longitude float64) (time.Time, error) {
+	return TimeAtAltitude(date, latitude, longitude, SunriseAltitude, true)
+}
+
+// Sunset returns the time of sunset on the date of date at the given coordinates, in date's location.
+func Sunset(date time.Time, latitude, longitude float64) (time.Time, error) {
+	return TimeAtAltitude(date, latitude, longitude, SunriseAltitude, false)
+}
+
+// Dawn returns the morning time at which the sun is depression degrees below the horizon,
+// e.g. 18 for astronomical dawn.
+func Dawn(date time.Time, latitude, longitude, depression float64) (time.Time, error) {
+	return TimeAtAltitude(date, latitude, longitude, -depression, true)
+}
+
+// Dusk returns the evening time at which the sun is depression degrees below the horizon,
+// e.g. 17 for the end of twilight as used for Isha.
+func Dusk(date time.Time, latitude, longitude, depression float64) (time.Time, error) {
+	return TimeAtAltitude(date, latitude, longitude, -depression, false)
+}
+
+// TimeAtAltitude returns the time on the date of date at which the sun's center reaches the given
+// altitude in degrees, before solar noon if rising is true and after it otherwise.
+// The result is in date's location. If the sun does not reach the altitude that day, [ErrNoEvent] is returned.
+func TimeAtAltitude(date time.Time, latitude, longitude, altitude float64, rising bool) (time.Time, error) {
+	noon := Noon(date, longitude)
+	t := noon
+	for range 3 {
+		decl, _ := position(t)
+		cosH := (math.Sin(rad(altitude)) - math.Sin(rad(latitude))*math.Sin(rad(decl))) /
+			(math.Cos(rad(latitude)) * math.Cos(rad(decl)))
+		if cosH < -1 || cosH > 1 {
+			return time.Time{}, ErrNoEvent
+		}
+
+		offset := minutes(4 * deg(math.Acos(cosH)))
+		if rising {
+			offset = -offset
+		}
+		t = noon.Add(offset)
+	}
+	return t.In(date.Location()), nil
+}
+
+// position returns the sun's declination in degrees and the equation of time in minutes at t.
+func position(t time.Time) (declination, equationOfTime float64) {
+	jc := (julianDay(t) - 2451545) / 36525
+
+	meanLong := math.Mod(280.46646+jc*(36000.76983+jc*0.0003032), 360)
+	meanAnom := 357.52911 + jc*(35999.05029-0.0001537*jc)
+	eccent := 0.016708634 - jc*(0.000042037+0.0000001267*jc)
+	center := math.Sin(rad(meanAnom))*(1.914602-jc*(0.004817+0.000014*jc)) +
+		math.Sin(rad(2*meanAnom))*(0.019993-0.000101*jc) +
+		math.Sin(rad(3*meanAnom))*0.000289
+	omega := 125.04 - 1934.136*jc
+	appLong := meanLong + center - 0.00569 - 0.00478*math.Sin(rad(omega))
+
+	meanObliq := 23 + (26+(21.448-jc*(46.815+jc*(0.00059-jc*0.001813)))/60)/60
+	obliq := meanObliq + 0.00256*math.Cos(rad(omega))
+
+	declination = deg(math.Asin(math.Sin(rad(obliq)) * math.Sin(rad(appLong))))
+
+	y := math.Pow(math.Tan(rad(obliq/2)), 2)
+	equationOfTime = 4 * deg(y*math.Sin(2*rad(meanLong))-
+		2*eccent*math.Sin(rad(meanAnom))+
+		4*eccent*y*math.Sin(rad(meanAnom))*math.Cos(2*rad(meanLong))-
+		0.5*y*y*math.Sin(4*rad(meanLong))-
+		1.25*eccent*eccent*math.Sin(2*rad(meanAnom)))
+
+	return declination, equationOfTime
+}
+
+// julianDay returns the Julian day of t.
+func julianDay(t time.Time) float64 {
+	return float64(t.UTC().UnixNano())/float64(24*time.Hour) + 2440587.5
+}
+
+// utcMidnight returns midnight UTC of the calendar date of date in its own location.
+func utcMidnight(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func minutes(m float64) time.Duration {
+	return time.Duration(m * float64(time.Minute))
+}
+
+func rad(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func deg(r float64) float64 {
+	return r * 180 / math.Pi
+}
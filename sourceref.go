@@ -0,0 +1,110 @@
+package diyanet
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// VerseReference is a parsed reference to one or more verses of the Quran, e.g. "(Şûrâ, 42/29)".
+type VerseReference struct {
+	// Surah is the name of the surah as given in the reference.
+	Surah string
+	// SurahNumber is the number of the surah (1–114).
+	SurahNumber int
+	// Verse is the number of the first verse.
+	Verse int
+	// LastVerse is the number of the last verse if a range is referenced, otherwise equal to Verse.
+	LastVerse int
+}
+
+// Key returns the reference in the common "surah:verse" or "surah:first-last" notation, e.g. "42:29".
+func (r VerseReference) Key() string {
+	if r.LastVerse > r.Verse {
+		return fmt.Sprintf("%d:%d-%d", r.SurahNumber, r.Verse, r.LastVerse)
+	}
+	return fmt.Sprintf("%d:%d", r.SurahNumber, r.Verse)
+}
+
+// HadithReference is a parsed reference to a hadith, e.g. "(Tirmizî, “Birr”, 15)".
+type HadithReference struct {
+	// Collection is the hadith collection or author, e.g. "Tirmizî".
+	Collection string
+	// Chapter is the book or chapter within the collection, e.g. "Birr", if given.
+	Chapter string
+	// Number is the hadith number or volume/page as given, e.g. "15" or "III, 25", if given.
+	Number string
+}
+
+// verseReferencePattern matches "Name, surah/verse" with an optional verse range.
+var verseReferencePattern = regexp.MustCompile(`^(.*?)[\s,.]*(\d+)\s*/\s*(\d+)(?:\s*[-–—]\s*(\d+))?$`)
+
+// quotedPattern matches a chapter name in double quotation marks of any style.
+// Apostrophes are not quotation marks here since they occur within transliterated names.
+var quotedPattern = regexp.MustCompile(`["“”„«»](.*?)["“”„«»]`)
+
+// ParseVerseReference parses a reference to Quran verses such as "(Şu'arâ, 26/80)" or "Bakara 2/255-256".
+func ParseVerseReference(s string) (VerseReference, error) {
+	m := verseReferencePattern.FindStringSubmatch(trimReference(s))
+	if m == nil {
+		return VerseReference{}, fmt.Errorf(errorPrefix+"invalid verse reference %q", s)
+	}
+
+	ref := VerseReference{Surah: strings.TrimSpace(m[1])}
+	ref.SurahNumber, _ = strconv.Atoi(m[2])
+	ref.Verse, _ = strconv.Atoi(m[3])
+	ref.LastVerse = ref.Verse
+	if m[4] != "" {
+		ref.LastVerse, _ = strconv.Atoi(m[4])
+	}
+	if ref.SurahNumber < 1 || ref.SurahNumber > 114 || ref.Verse < 1 || ref.LastVerse < ref.Verse {
+		return VerseReference{}, fmt.Errorf(errorPrefix+"invalid verse reference %q", s)
+	}
+	return ref, nil
+}
+
+// ParseHadithReference parses a reference to a hadith such as "(Tirmizî, “Birr ”, 15)" or "Müslim, Îmân, 93".
+func ParseHadithReference(s string) (HadithReference, error) {
+	rest := trimReference(s)
+	if rest == "" {
+		return HadithReference{}, fmt.Errorf(errorPrefix+"invalid hadith reference %q", s)
+	}
+
+	var ref HadithReference
+	if m := quotedPattern.FindStringSubmatchIndex(rest); m != nil {
+		ref.Chapter = strings.TrimSpace(rest[m[2]:m[3]])
+		ref.Collection = strings.Trim(rest[:m[0]], " ,")
+		ref.Number = strings.Trim(rest[m[1]:], " ,")
+	} else {
+		parts := strings.Split(rest, ",")
+		ref.Collection = strings.TrimSpace(parts[0])
+		if len(parts) > 2 {
+			ref.Chapter = strings.TrimSpace(parts[1])
+			parts = parts[1:]
+		}
+		ref.Number = strings.TrimSpace(strings.Join(parts[1:], ","))
+	}
+
+	if ref.Collection == "" {
+		return HadithReference{}, fmt.Errorf(errorPrefix+"invalid hadith reference %q", s)
+	}
+	return ref, nil
+}
+
+// VerseReference returns the parsed VerseSource.
+func (d DailyContent) VerseReference() (VerseReference, error) {
+	return ParseVerseReference(d.VerseSource)
+}
+
+// HadithReference returns the parsed HadithSource.
+func (d DailyContent) HadithReference() (HadithReference, error) {
+	return ParseHadithReference(d.HadithSource)
+}
+
+// trimReference removes enclosing parentheses, surrounding punctuation and redundant whitespace.
+func trimReference(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	s = strings.TrimSpace(strings.Trim(s, "()[] .;"))
+	return s
+}
@@ -0,0 +1,164 @@
+package diyanet
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SQLCache is a persistent [Cache] stored in an SQLite database, so that responses and prayer times
+// survive restarts and API outages. It also stores parsed timetables per city.
+//
+// The database is opened by the caller with any SQLite driver registered with [database/sql]
+// (e.g. modernc.org/sqlite or github.com/mattn/go-sqlite3); this package does not depend on one.
+type SQLCache struct {
+	db *sql.DB
+}
+
+// NewSQLCache returns a cache stored in db, creating its tables if they do not exist yet.
+func NewSQLCache(ctx context.Context, db *sql.DB) (*SQLCache, error) {
+	for _, stmt := range []string{
+		`CREATE TABLE IF NOT EXISTS diyanet_cache (
+			key     TEXT PRIMARY KEY,
+			value   BLOB NOT NULL,
+			expires INTEGER NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS diyanet_prayer_times (
+			city_id INTEGER NOT NULL,
+			date    TEXT NOT NULL,
+			data    TEXT NOT NULL,
+			PRIMARY KEY (city_id, date)
+		)`,
+	} {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"unable to create cache tables: %w", err)
+		}
+	}
+	return &SQLCache{db: db}, nil
+}
+
+// Get implements [Cache]. Database errors are reported as misses.
+func (s *SQLCache) Get(ctx context.Context, key string) ([]byte, bool) {
+	var value []byte
+	err := s.db.QueryRowContext(ctx,
+		`SELECT value FROM diyanet_cache WHERE key = ? AND expires > ?`,
+		key, time.Now().UnixMilli()).Scan(&value)
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// Set implements [Cache]. Database errors are ignored.
+func (s *SQLCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) {
+	_, _ = s.db.ExecContext(ctx,
+		`INSERT INTO diyanet_cache (key, value, expires) VALUES (?, ?, ?)
+		ON CONFLICT (key) DO UPDATE SET value = excluded.value, expires = excluded.expires`,
+		key, value, time.Now().Add(ttl).UnixMilli())
+}
+
+// Prune deletes expired responses.
+func (s *SQLCache) Prune(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM diyanet_cache WHERE expires <= ?`, time.Now().UnixMilli())
+	if err != nil {
+		return fmt.Errorf(errorPrefix+"unable to prune cache: %w", err)
+	}
+	return nil
+}
+
+// Entries implements [CacheInspector].
+func (s *SQLCache) Entries(ctx context.Context) ([]CacheEntry, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT key, expires, length(value) FROM diyanet_cache ORDER BY key`)
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to list cache entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []CacheEntry
+	for rows.Next() {
+		var (
+			entry   CacheEntry
+			expires int64
+		)
+		if err := rows.Scan(&entry.Key, &expires, &entry.Size); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"unable to list cache entries: %w", err)
+		}
+		entry.Expires = time.UnixMilli(expires)
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to list cache entries: %w", err)
+	}
+	return entries, nil
+}
+
+// Delete implements [CacheInspector].
+func (s *SQLCache) Delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		if _, err := s.db.ExecContext(ctx, `DELETE FROM diyanet_cache WHERE key = ?`, key); err != nil {
+			return fmt.Errorf(errorPrefix+"unable to delete cache entry %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// StoreTimetable stores the prayer times of the timetable under their CityId and date,
+// replacing previously stored days.
+func (s *SQLCache) StoreTimetable(ctx context.Context, t Timetable) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf(errorPrefix+"unable to store timetable: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, pt := range t {
+		data, err := json.Marshal(pt)
+		if err != nil {
+			return fmt.Errorf(errorPrefix+"unable to encode prayer times of %s: %w", dateKey(pt.GregorianDate), err)
+		}
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO diyanet_prayer_times (city_id, date, data) VALUES (?, ?, ?)
+			ON CONFLICT (city_id, date) DO UPDATE SET data = excluded.data`,
+			pt.CityId, dateKey(pt.GregorianDate), string(data))
+		if err != nil {
+			return fmt.Errorf(errorPrefix+"unable to store prayer times of %s: %w", dateKey(pt.GregorianDate), err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to store timetable: %w", err)
+	}
+	return nil
+}
+
+// Timetable returns the stored prayer times of the city from start to end, both dates inclusive.
+func (s *SQLCache) Timetable(ctx context.Context, cityID int, start, end time.Time) (Timetable, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT data FROM diyanet_prayer_times WHERE city_id = ? AND date >= ? AND date <= ? ORDER BY date`,
+		cityID, dateKey(start), dateKey(end))
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to load timetable of city %d: %w", cityID, err)
+	}
+	defer rows.Close()
+
+	var times []PrayerTime
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"unable to load timetable of city %d: %w", cityID, err)
+		}
+
+		var pt PrayerTime
+		if err := json.Unmarshal([]byte(data), &pt); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"unable to decode stored prayer times of city %d: %w", cityID, err)
+		}
+		times = append(times, pt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"unable to load timetable of city %d: %w", cityID, err)
+	}
+
+	return NewTimetable(times), nil
+}
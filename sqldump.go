@@ -0,0 +1,181 @@
+package diyanet
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// SQLDialect selects the SQL dialect of the dumps written by [PlaceTree.WriteSQL] and [Timetable.WriteSQL].
+type SQLDialect int
+
+const (
+	// SQLite writes statements for SQLite 3.24 or later.
+	SQLite SQLDialect = iota
+	// Postgres writes statements for PostgreSQL 9.5 or later, with DATE and TIME columns.
+	Postgres
+)
+
+// SQLDumpOptions configures [PlaceTree.WriteSQL] and [Timetable.WriteSQL].
+type SQLDumpOptions struct {
+	// Dialect is the SQL dialect written.
+	Dialect SQLDialect
+	// TablePrefix prefixes the table names. Defaults to "diyanet_".
+	TablePrefix string
+	// NoCreate omits the CREATE TABLE statements, for loading into existing tables.
+	NoCreate bool
+}
+
+// table returns the name of the table with the prefix.
+func (o SQLDumpOptions) table(name string) string {
+	if o.TablePrefix == "" {
+		return "diyanet_" + name
+	}
+	return o.TablePrefix + name
+}
+
+// types returns the column types of dates and clock times of the dialect.
+func (o SQLDumpOptions) types() (date, clock string) {
+	if o.Dialect == Postgres {
+		return "DATE", "TIME"
+	}
+	return "TEXT", "TEXT"
+}
+
+// WriteSQL writes the place hierarchy as SQL statements creating and filling the tables countries, states and
+// cities, so that other systems can load it without an importer. Rows are inserted or updated by ID, so a dump
+// may be loaded repeatedly. The statements run in a transaction.
+func (t *PlaceTree) WriteSQL(w io.Writer, opts SQLDumpOptions) error {
+	countries, states, cities := opts.table("countries"), opts.table("states"), opts.table("cities")
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString("BEGIN;\n")
+	if !opts.NoCreate {
+		fmt.Fprintf(bw, `CREATE TABLE IF NOT EXISTS %[1]s (
+  id INTEGER PRIMARY KEY,
+  code TEXT NOT NULL,
+  name TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS %[2]s (
+  id INTEGER PRIMARY KEY,
+  country_id INTEGER NOT NULL REFERENCES %[1]s (id),
+  code TEXT NOT NULL,
+  name TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS %[3]s (
+  id INTEGER PRIMARY KEY,
+  state_id INTEGER NOT NULL REFERENCES %[2]s (id),
+  code TEXT NOT NULL,
+  name TEXT NOT NULL
+);
+`, countries, states, cities)
+	}
+
+	for _, country := range t.Countries {
+		fmt.Fprintf(bw, "INSERT INTO %s (id, code, name) VALUES (%d, %s, %s)"+
+			" ON CONFLICT (id) DO UPDATE SET code = excluded.code, name = excluded.name;\n",
+			countries, country.Country.Id, sqlString(country.Country.Code), sqlString(country.Country.Name))
+	}
+	for _, country := range t.Countries {
+		for _, state := range country.States {
+			fmt.Fprintf(bw, "INSERT INTO %s (id, country_id, code, name) VALUES (%d, %d, %s, %s)"+
+				" ON CONFLICT (id) DO UPDATE SET country_id = excluded.country_id, code = excluded.code, name = excluded.name;\n",
+				states, state.State.Id, country.Country.Id, sqlString(state.State.Code), sqlString(state.State.Name))
+		}
+	}
+	for _, country := range t.Countries {
+		for _, state := range country.States {
+			for _, city := range state.Cities {
+				fmt.Fprintf(bw, "INSERT INTO %s (id, state_id, code, name) VALUES (%d, %d, %s, %s)"+
+					" ON CONFLICT (id) DO UPDATE SET state_id = excluded.state_id, code = excluded.code, name = excluded.name;\n",
+					cities, city.City.Id, state.State.Id, sqlString(city.City.Code), sqlString(city.City.Name))
+			}
+		}
+	}
+	bw.WriteString("COMMIT;\n")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write places as SQL: %w", err)
+	}
+	return nil
+}
+
+// timetableSQLColumns are the columns of the timetable table after city_id and date, with their values.
+var timetableSQLColumns = []struct {
+	name  string
+	clock bool
+	value func(pt PrayerTime) string
+}{
+	{"hijri_date", false, func(pt PrayerTime) string { return pt.HijriDateShort }},
+	{"hijri_date_long", false, func(pt PrayerTime) string { return pt.HijriDateLong }},
+	{"gregorian_date_long", false, func(pt PrayerTime) string { return pt.GregorianDateLong }},
+	{"fajr", true, func(pt PrayerTime) string { return pt.Fajr }},
+	{"sunrise", true, func(pt PrayerTime) string { return pt.Sunrise }},
+	{"dhuhr", true, func(pt PrayerTime) string { return pt.Dhuhr }},
+	{"asr", true, func(pt PrayerTime) string { return pt.Asr }},
+	{"maghrib", true, func(pt PrayerTime) string { return pt.Maghrib }},
+	{"isha", true, func(pt PrayerTime) string { return pt.Isha }},
+	{"astronomical_sunrise", true, func(pt PrayerTime) string { return pt.AstronomicalSunrise }},
+	{"astronomical_sunset", true, func(pt PrayerTime) string { return pt.AstronomicalSunset }},
+	{"qibla_time", true, func(pt PrayerTime) string { return pt.QiblaTime }},
+}
+
+// WriteSQL writes the timetable as SQL statements creating and filling the table timetable, with a row per city
+// and date. Rows are inserted or updated by city and date, so dumps of overlapping periods may be loaded one
+// after another. The statements run in a transaction. Clock times are local to the city, as published.
+func (t Timetable) WriteSQL(w io.Writer, opts SQLDumpOptions) error {
+	table := opts.table("timetable")
+	dateType, clockType := opts.types()
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString("BEGIN;\n")
+	if !opts.NoCreate {
+		fmt.Fprintf(bw, "CREATE TABLE IF NOT EXISTS %s (\n  city_id INTEGER NOT NULL,\n  date %s NOT NULL,\n", table, dateType)
+		for _, column := range timetableSQLColumns {
+			columnType := "TEXT"
+			if column.clock {
+				columnType = clockType
+			}
+			fmt.Fprintf(bw, "  %s %s,\n", column.name, columnType)
+		}
+		bw.WriteString("  gmt_offset REAL,\n  PRIMARY KEY (city_id, date)\n);\n")
+	}
+
+	names := []string{"city_id", "date"}
+	updates := make([]string, 0, len(timetableSQLColumns)+1)
+	for _, column := range timetableSQLColumns {
+		names = append(names, column.name)
+		updates = append(updates, column.name+" = excluded."+column.name)
+	}
+	names = append(names, "gmt_offset")
+	updates = append(updates, "gmt_offset = excluded.gmt_offset")
+	insert := fmt.Sprintf("INSERT INTO %s (%s) VALUES (", table, strings.Join(names, ", "))
+	conflict := ") ON CONFLICT (city_id, date) DO UPDATE SET " + strings.Join(updates, ", ") + ";\n"
+
+	for _, pt := range t {
+		values := []string{strconv.Itoa(pt.CityId), sqlString(dateKey(pt.GregorianDate))}
+		for _, column := range timetableSQLColumns {
+			value := column.value(pt)
+			if value == "" {
+				values = append(values, "NULL")
+			} else {
+				values = append(values, sqlString(value))
+			}
+		}
+		values = append(values, strconv.FormatFloat(float64(pt.GreenwichMeanTimeZone), 'g', -1, 32))
+		bw.WriteString(insert + strings.Join(values, ", ") + conflict)
+	}
+	bw.WriteString("COMMIT;\n")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write timetable as SQL: %w", err)
+	}
+	return nil
+}
+
+// sqlString returns s as an SQL string literal.
+func sqlString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,75 @@
+package diyanet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+)
+
+// staleMagic prefixes cache values stored with their freshness lifetime for [Config.StaleWhileRevalidate].
+var staleMagic = []byte("diyanet-swr1\x00")
+
+// lookup returns the cached response for url. With stale-while-revalidate enabled, an expired response
+// is returned as well and refreshed in the background.
+func (c Client) lookup(url string, ttl time.Duration) ([]byte, bool) {
+	value, ok := c.cache.Get(c.ctx, c.cacheKey(url))
+	c.stats.record(ok)
+	if !ok {
+		return nil, false
+	}
+	if c.staleWhileRevalidate <= 0 {
+		return value, true
+	}
+
+	body, freshUntil, ok := decodeStale(value)
+	if !ok {
+		return value, true
+	}
+	if time.Now().After(freshUntil) {
+		c.revalidate(url, ttl)
+	}
+	return body, true
+}
+
+// store caches the response for url for ttl. With stale-while-revalidate enabled, it is kept for longer
+// together with the time until which it is fresh. The daily content is never served stale,
+// since it would be the content of the previous day.
+func (c Client) store(url string, body []byte, ttl time.Duration) {
+	if c.staleWhileRevalidate <= 0 || url == apiURLDailyContent {
+		c.cache.Set(c.ctx, c.cacheKey(url), body, ttl)
+		return
+	}
+	c.cache.Set(c.ctx, c.cacheKey(url), encodeStale(body, time.Now().Add(ttl)), ttl+c.staleWhileRevalidate)
+}
+
+// revalidate refreshes the cached response for url in the background, unless a refresh is already running.
+// Failed refreshes leave the expired response in place.
+func (c Client) revalidate(url string, ttl time.Duration) {
+	if c.revalidating == nil {
+		return
+	}
+
+	key := c.cacheKey(url)
+	if _, running := c.revalidating.LoadOrStore(key, struct{}{}); running {
+		return
+	}
+	go func() {
+		defer c.revalidating.Delete(key)
+		_, _ = c.fetch(url, ttl)
+	}()
+}
+
+func encodeStale(body []byte, freshUntil time.Time) []byte {
+	value := make([]byte, 0, len(staleMagic)+8+len(body))
+	value = append(value, staleMagic...)
+	value = binary.BigEndian.AppendUint64(value, uint64(freshUntil.UnixMilli()))
+	return append(value, body...)
+}
+
+func decodeStale(value []byte) (body []byte, freshUntil time.Time, ok bool) {
+	if !bytes.HasPrefix(value, staleMagic) || len(value) < len(staleMagic)+8 {
+		return nil, time.Time{}, false
+	}
+	value = value[len(staleMagic):]
+	return value[8:], time.UnixMilli(int64(binary.BigEndian.Uint64(value))), true
+}
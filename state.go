@@ -12,6 +12,8 @@ const apiURLStatesByCountry = apiURLPrefix + "api/Place/States/%d"
 type State struct {
 	// client is the Diyanet Awqat Salah API client.
 	client Client
+	// country is the country the state belongs to, if known.
+	country *Country
 	// Id is the unique identifier for the state.
 	Id int
 	// Code is the code of the state.
@@ -22,14 +24,13 @@ type State struct {
 
 // GetStates retrieves the list of states from the Diyanet Awqat Salah API.
 func (c Client) GetStates() ([]State, error) {
-	resp, err := c.get(apiURLStates)
+	body, err := c.get(apiURLStates)
 	if err != nil {
 		return nil, fmt.Errorf(errorPrefix+"unable to get states: %w", err)
 	}
-	defer resp.Body.Close()
 
 	var result Result[[]State]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil, fmt.Errorf(errorPrefix+"unable to decode states response: %w", err)
 	}
 	if !result.Ok {
@@ -46,16 +47,15 @@ func (c Client) GetStates() ([]State, error) {
 // GetStates retrieves the list of states for a given country ID from the Diyanet Awqat Salah API.
 func (c Country) GetStates() ([]State, error) {
 	url := fmt.Sprintf(apiURLStatesByCountry, c.Id)
-	resp, err := c.client.get(url)
+	body, err := c.client.get(url)
 	if err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to get states for country %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
 	}
-	defer resp.Body.Close()
 
 	var result Result[[]State]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil,
 			fmt.Errorf(errorPrefix+"unable to decode states response for country %s (%d – %s): %w",
 				c.Name, c.Id, c.Code, err)
@@ -68,6 +68,7 @@ func (c Country) GetStates() ([]State, error) {
 
 	for i := range result.Data {
 		result.Data[i].client = c.client
+		result.Data[i].country = &c
 	}
 
 	return result.Data, nil
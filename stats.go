@@ -0,0 +1,80 @@
+package diyanet
+
+import (
+	"errors"
+	"time"
+)
+
+// DayLength is the length of daylight, from Sunrise to Maghrib, on a date.
+type DayLength struct {
+	// Date is the date of the day.
+	Date time.Time
+	// Length is the duration from Sunrise to Maghrib.
+	Length time.Duration
+}
+
+// TimetableStats summarizes a timetable, e.g. for publishing Ramadan overviews.
+type TimetableStats struct {
+	// Days is the number of days summarized.
+	Days int
+	// EarliestFajr is the Fajr time with the earliest wall clock time.
+	EarliestFajr time.Time
+	// LatestFajr is the Fajr time with the latest wall clock time.
+	LatestFajr time.Time
+	// DayLengths holds the daylight duration of every day.
+	DayLengths []DayLength
+	// DayLengthTrend is the average change of the day length from one day to the next.
+	DayLengthTrend time.Duration
+	// TotalFasting is the sum of the fasting durations (Fajr to Maghrib) of all days,
+	// i.e. the total fasting hours if the timetable covers Ramadan.
+	TotalFasting time.Duration
+	// AverageMaghribIshaGap is the average duration from Maghrib to Isha.
+	AverageMaghribIshaGap time.Duration
+}
+
+// Stats computes summary statistics of the timetable.
+func (t Timetable) Stats() (TimetableStats, error) {
+	if len(t) == 0 {
+		return TimetableStats{}, errors.New(errorPrefix + "cannot compute statistics of an empty timetable")
+	}
+
+	stats := TimetableStats{
+		Days:       len(t),
+		DayLengths: make([]DayLength, 0, len(t)),
+	}
+
+	var ishaGaps time.Duration
+	for i, pt := range t {
+		day, err := pt.Day()
+		if err != nil {
+			return TimetableStats{}, err
+		}
+
+		fajr := day.Times[Fajr]
+		if i == 0 || clockOf(fajr) < clockOf(stats.EarliestFajr) {
+			stats.EarliestFajr = fajr
+		}
+		if i == 0 || clockOf(fajr) > clockOf(stats.LatestFajr) {
+			stats.LatestFajr = fajr
+		}
+
+		stats.DayLengths = append(stats.DayLengths, DayLength{
+			Date:   pt.GregorianDate,
+			Length: day.Times[Maghrib].Sub(day.Times[Sunrise]),
+		})
+		stats.TotalFasting += day.Times[Maghrib].Sub(fajr)
+		ishaGaps += day.Times[Isha].Sub(day.Times[Maghrib])
+	}
+
+	if n := len(stats.DayLengths); n > 1 {
+		stats.DayLengthTrend = (stats.DayLengths[n-1].Length - stats.DayLengths[0].Length) / time.Duration(n-1)
+	}
+	stats.AverageMaghribIshaGap = ishaGaps / time.Duration(len(t))
+
+	return stats, nil
+}
+
+// clockOf returns the wall clock time of day of t.
+func clockOf(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+}
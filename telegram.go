@@ -0,0 +1,85 @@
+package diyanet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+)
+
+// DefaultTelegramURL is the URL of the Telegram Bot API.
+const DefaultTelegramURL = "https://api.telegram.org/"
+
+// telegramMaxLength is the maximum length of a Telegram message in characters, not counting markup.
+const telegramMaxLength = 4096
+
+// TelegramNotifier sends notifications to Telegram chats through a bot, see https://core.telegram.org/bots/api.
+//
+// Create the bot with @BotFather and add it to the chats, groups or channels it should post to.
+type TelegramNotifier struct {
+	// Token is the bot token issued by @BotFather.
+	Token string
+	// Chats are the chats notifications are sent to, each with its own preferences.
+	Chats []TelegramChat
+	// BaseURL is the URL of the Bot API server. Defaults to [DefaultTelegramURL].
+	BaseURL string
+	// HTTPClient is the HTTP client used to make requests. Defaults to [http.DefaultClient].
+	HTTPClient *http.Client
+}
+
+// TelegramChat is a recipient of a [TelegramNotifier].
+type TelegramChat struct {
+	// ChatID is the numeric ID of the chat or the username of a channel, e.g. "@mosque_berlin".
+	ChatID string
+	// Filter selects the notifications sent to the chat, e.g. only the prayers of the chat's city.
+	Filter NotificationFilter
+}
+
+// telegramResponse is the envelope of a Bot API response.
+type telegramResponse struct {
+	Ok          bool   `json:"ok"`
+	Description string `json:"description"`
+}
+
+// Send sends the notification, formatted with a bold title, to every chat whose filter selects it.
+// Failed chats do not prevent sending to the others; their errors are returned joined.
+func (t TelegramNotifier) Send(ctx context.Context, n Notification) error {
+	title := truncate(n.Title, telegramMaxLength/2)
+	text := "<b>" + html.EscapeString(title) + "</b>"
+	if n.Text != "" {
+		// The limit applies to the text without markup.
+		text += "\n\n" + html.EscapeString(truncate(n.Text, telegramMaxLength-len([]rune(title))-2))
+	}
+
+	var errs []error
+	for _, chat := range t.Chats {
+		if !chat.Filter.Matches(n) {
+			continue
+		}
+		if err := t.sendMessage(ctx, chat.ChatID, text); err != nil {
+			errs = append(errs, fmt.Errorf("%w (chat %s)", err, chat.ChatID))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sendMessage sends an HTML formatted message to the chat.
+func (t TelegramNotifier) sendMessage(ctx context.Context, chatID, text string) error {
+	base := t.BaseURL
+	if base == "" {
+		base = DefaultTelegramURL
+	}
+
+	var resp telegramResponse
+	err := postJSON(ctx, t.HTTPClient, "telegram", strings.TrimSuffix(base, "/")+"/bot"+t.Token+"/sendMessage", nil,
+		map[string]any{"chat_id": chatID, "text": text, "parse_mode": "HTML"}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return errors.New(errorPrefix + "telegram request failed: " + resp.Description)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package diyanet
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Timetable is a calendar of prayer times sorted by date with at most one entry per date.
+// Use [NewTimetable] to build one from the results of the prayer time requests.
+type Timetable []PrayerTime
+
+// NewTimetable returns a timetable holding the given prayer times, sorted by date.
+// If several entries exist for a date, the one given last wins.
+func NewTimetable(times ...[]PrayerTime) Timetable {
+	var t Timetable
+	for _, ts := range times {
+		t = append(t, ts...)
+	}
+
+	slices.SortStableFunc(t, func(a, b PrayerTime) int {
+		return strings.Compare(dateKey(a.GregorianDate), dateKey(b.GregorianDate))
+	})
+
+	deduped := t[:0]
+	for i, pt := range t {
+		if i+1 < len(t) && dateKey(t[i+1].GregorianDate) == dateKey(pt.GregorianDate) {
+			continue
+		}
+		deduped = append(deduped, pt)
+	}
+
+	return slices.Clip(deduped)
+}
+
+// ByDate returns the prayer times of the given date.
+func (t Timetable) ByDate(date time.Time) (PrayerTime, bool) {
+	i, found := t.search(date)
+	if !found {
+		return PrayerTime{}, false
+	}
+	return t[i], true
+}
+
+// All returns an iterator over the dates and their prayer times in chronological order.
+func (t Timetable) All() iter.Seq2[time.Time, PrayerTime] {
+	return func(yield func(time.Time, PrayerTime) bool) {
+		for _, pt := range t {
+			if !yield(pt.GregorianDate, pt) {
+				return
+			}
+		}
+	}
+}
+
+// Merge returns a new timetable holding the days of t and others.
+// For dates present in several timetables, the entry of the last one wins.
+func (t Timetable) Merge(others ...Timetable) Timetable {
+	times := make([][]PrayerTime, 0, 1+len(others))
+	times = append(times, t)
+	for _, other := range others {
+		times = append(times, other)
+	}
+	return NewTimetable(times...)
+}
+
+// Range returns the part of the timetable from start to end, both dates inclusive.
+// The result shares its backing array with t.
+func (t Timetable) Range(start, end time.Time) Timetable {
+	from, _ := t.search(start)
+	to, found := t.search(end)
+	if found {
+		to++
+	}
+	if to < from {
+		return nil
+	}
+	return t[from:to]
+}
+
+// Dates returns the first and last date of the timetable.
+func (t Timetable) Dates() (first, last time.Time, ok bool) {
+	if len(t) == 0 {
+		return time.Time{}, time.Time{}, false
+	}
+	return t[0].GregorianDate, t[len(t)-1].GregorianDate, true
+}
+
+// months returns the timetable split into calendar months.
+func (t Timetable) months() []Timetable {
+	var months []Timetable
+	for i, pt := range t {
+		if i == 0 || pt.GregorianDate.Month() != t[i-1].GregorianDate.Month() || pt.GregorianDate.Year() != t[i-1].GregorianDate.Year() {
+			months = append(months, nil)
+		}
+		months[len(months)-1] = append(months[len(months)-1], pt)
+	}
+	return months
+}
+
+// search returns the index of date in t, or where it would be inserted.
+func (t Timetable) search(date time.Time) (int, bool) {
+	return slices.BinarySearchFunc(t, dateKey(date), func(pt PrayerTime, key string) int {
+		return strings.Compare(dateKey(pt.GregorianDate), key)
+	})
+}
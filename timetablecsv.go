@@ -0,0 +1,91 @@
+package diyanet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TimetableColumn is a column of a timetable export such as [Timetable.WriteCSV].
+type TimetableColumn struct {
+	// Header is the heading of the column.
+	Header string
+	// Value returns the value of the column for a day.
+	Value func(pt PrayerTime) string
+}
+
+// Columns of the dates of a day for timetable exports; see [PrayerColumn] for the prayer times.
+var (
+	// DateColumn holds the Gregorian date as YYYY-MM-DD.
+	DateColumn = TimetableColumn{Header: "date", Value: func(pt PrayerTime) string { return dateKey(pt.GregorianDate) }}
+	// WeekdayColumn holds the English name of the weekday.
+	WeekdayColumn = TimetableColumn{Header: "weekday", Value: func(pt PrayerTime) string { return pt.GregorianDate.Weekday().String() }}
+	// GregorianDateLongColumn holds the long Gregorian date as published, e.g. "5 Mart 2026 Perşembe".
+	GregorianDateLongColumn = TimetableColumn{Header: "gregorian_date", Value: func(pt PrayerTime) string { return pt.GregorianDateLong }}
+	// HijriDateColumn holds the short Hijri date as published, e.g. "16.9.1447".
+	HijriDateColumn = TimetableColumn{Header: "hijri_date", Value: func(pt PrayerTime) string { return pt.HijriDateShort }}
+	// HijriDateLongColumn holds the long Hijri date as published, e.g. "16 Ramazan 1447".
+	HijriDateLongColumn = TimetableColumn{Header: "hijri_date_long", Value: func(pt PrayerTime) string { return pt.HijriDateLong }}
+)
+
+// PrayerColumn returns the column of the clock time of the prayer, headed by its lower-case name.
+func PrayerColumn(name PrayerName) TimetableColumn {
+	return TimetableColumn{
+		Header: strings.ToLower(name.String()),
+		Value:  func(pt PrayerTime) string { return pt.Clock(name) },
+	}
+}
+
+// DefaultTimetableColumns returns the columns exported by default: the Gregorian and Hijri date
+// followed by the six prayer times.
+func DefaultTimetableColumns() []TimetableColumn {
+	columns := []TimetableColumn{DateColumn, HijriDateColumn}
+	for _, name := range PrayerNames {
+		columns = append(columns, PrayerColumn(name))
+	}
+	return columns
+}
+
+// CSVOptions configures [Timetable.WriteCSV].
+type CSVOptions struct {
+	// Columns are the columns written. Defaults to [DefaultTimetableColumns].
+	Columns []TimetableColumn
+	// Delimiter separates the fields. Defaults to a comma; spreadsheets in Turkish locales expect ';'.
+	Delimiter rune
+	// NoHeader omits the header row.
+	NoHeader bool
+}
+
+// WriteCSV writes the timetable as CSV with one row per day.
+func (t Timetable) WriteCSV(w io.Writer, opts CSVOptions) error {
+	columns := opts.Columns
+	if len(columns) == 0 {
+		columns = DefaultTimetableColumns()
+	}
+
+	cw := csv.NewWriter(w)
+	if opts.Delimiter != 0 {
+		cw.Comma = opts.Delimiter
+	}
+
+	record := make([]string, len(columns))
+	if !opts.NoHeader {
+		for i, column := range columns {
+			record[i] = column.Header
+		}
+		_ = cw.Write(record)
+	}
+	for _, pt := range t {
+		for i, column := range columns {
+			record[i] = column.Value(pt)
+		}
+		_ = cw.Write(record)
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write timetable as CSV: %w", err)
+	}
+	return nil
+}
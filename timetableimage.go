@@ -0,0 +1,329 @@
+package diyanet
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"time"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// ImageTheme holds the colors of the images rendered by [Timetable.WritePNG] and [Timetable.WriteSVG].
+type ImageTheme struct {
+	// Background is the color of the image background.
+	Background color.Color
+	// Foreground is the color of the text.
+	Foreground color.Color
+	// Muted is the color of secondary text such as dates and Sunrise.
+	Muted color.Color
+	// Accent is the color of the title and the table header background.
+	Accent color.Color
+	// Stripe is the background of every other row.
+	Stripe color.Color
+}
+
+// Themes for timetable images.
+var (
+	// LightTheme sets dark text on white, for printing and websites.
+	LightTheme = ImageTheme{
+		Background: color.RGBA{0xFF, 0xFF, 0xFF, 0xFF},
+		Foreground: color.RGBA{0x21, 0x21, 0x21, 0xFF},
+		Muted:      color.RGBA{0x75, 0x75, 0x75, 0xFF},
+		Accent:     color.RGBA{0x1B, 0x5E, 0x5A, 0xFF},
+		Stripe:     color.RGBA{0xF1, 0xF5, 0xF4, 0xFF},
+	}
+	// DarkTheme sets light text on a dark background, for digital signage and status images.
+	DarkTheme = ImageTheme{
+		Background: color.RGBA{0x12, 0x1A, 0x1C, 0xFF},
+		Foreground: color.RGBA{0xEC, 0xEF, 0xF1, 0xFF},
+		Muted:      color.RGBA{0x90, 0xA4, 0xAE, 0xFF},
+		Accent:     color.RGBA{0x4D, 0xB6, 0xAC, 0xFF},
+		Stripe:     color.RGBA{0x1C, 0x27, 0x2A, 0xFF},
+	}
+)
+
+// ImageOptions configures [Timetable.WritePNG] and [Timetable.WriteSVG].
+type ImageOptions struct {
+	// Title heads the image, typically the city name.
+	Title string
+	// Theme holds the colors. Defaults to [LightTheme].
+	Theme *ImageTheme
+	// Scale multiplies the size of PNG images, e.g. 2 for high-density displays. Defaults to 1.
+	Scale float64
+	// Font and BoldFont are TrueType or OpenType fonts used for PNG images.
+	// They default to the Go fonts, which cover Turkish and the other Latin scripts.
+	Font, BoldFont []byte
+	// FontFamily is the CSS font family of SVG images. Defaults to sans-serif fonts that cover Turkish.
+	FontFamily string
+}
+
+// Text anchors of the texts of an image layout.
+const (
+	anchorStart = iota
+	anchorMiddle
+	anchorEnd
+)
+
+// imageRect is a filled rectangle of an image layout.
+type imageRect struct {
+	x, y, width, height float64
+	color               color.Color
+}
+
+// imageText is a line of text of an image layout, positioned at its baseline.
+type imageText struct {
+	x, y   float64
+	size   float64
+	bold   bool
+	anchor int
+	color  color.Color
+	s      string
+}
+
+// imageLayout is the output-independent layout of a timetable image, in pixels at scale 1.
+type imageLayout struct {
+	width, height float64
+	background    color.Color
+	rects         []imageRect
+	texts         []imageText
+}
+
+func (l *imageLayout) rect(x, y, width, height float64, c color.Color) {
+	l.rects = append(l.rects, imageRect{x, y, width, height, c})
+}
+
+func (l *imageLayout) text(x, y, size float64, bold bool, anchor int, c color.Color, s string) {
+	l.texts = append(l.texts, imageText{x, y, size, bold, anchor, c, s})
+}
+
+// layout returns the layout of the timetable: a card listing the prayers for a single day,
+// a table with a row per day otherwise.
+func (t Timetable) layout(opts ImageOptions) (*imageLayout, error) {
+	if len(t) == 0 {
+		return nil, errors.New(errorPrefix + "unable to render empty timetable")
+	}
+	theme := LightTheme
+	if opts.Theme != nil {
+		theme = *opts.Theme
+	}
+	if len(t) == 1 {
+		return dayLayout(t[0], opts.Title, theme), nil
+	}
+	return tableLayout(t, opts.Title, theme), nil
+}
+
+// dayLayout returns the layout of the prayer times of a single day.
+func dayLayout(pt PrayerTime, title string, theme ImageTheme) *imageLayout {
+	const width, margin, rowHeight = 480, 32, 56
+	l := &imageLayout{width: width, background: theme.Background}
+
+	y := float64(margin)
+	if title != "" {
+		y += 32
+		l.text(margin, y, 30, true, anchorStart, theme.Accent, title)
+	}
+	y += 28
+	date := pt.GregorianDate.Format("Monday, 2 January 2006")
+	if pt.HijriDateLong != "" {
+		date += " · " + pt.HijriDateLong
+	}
+	l.text(margin, y, 18, false, anchorStart, theme.Muted, date)
+	y += 20
+
+	for i, name := range PrayerNames {
+		if i%2 == 0 {
+			l.rect(margin/2, y, width-margin, rowHeight, theme.Stripe)
+		}
+		c := theme.Foreground
+		if name == Sunrise {
+			c = theme.Muted
+		}
+		l.text(margin, y+rowHeight/2+10, 28, false, anchorStart, c, name.String())
+		l.text(width-margin, y+rowHeight/2+10, 28, true, anchorEnd, c, pt.Clock(name))
+		y += rowHeight
+	}
+	l.height = y + margin
+	return l
+}
+
+// tableLayout returns the layout of the prayer times of several days as a table.
+func tableLayout(t Timetable, title string, theme ImageTheme) *imageLayout {
+	const margin, rowHeight, dateWidth, hijriWidth, prayerWidth = 24, 28, 150, 100, 80
+	width := float64(2*margin + dateWidth + hijriWidth + prayerWidth*len(PrayerNames))
+	l := &imageLayout{width: width, background: theme.Background}
+
+	y := float64(margin)
+	if title != "" {
+		y += 26
+		l.text(margin, y, 24, true, anchorStart, theme.Accent, title)
+		y += 14
+	}
+
+	l.rect(margin, y, width-2*margin, rowHeight, theme.Accent)
+	baseline := rowHeight/2 + 5.0
+	l.text(margin+8, y+baseline, 14, true, anchorStart, theme.Background, "Date")
+	l.text(margin+dateWidth+8, y+baseline, 14, true, anchorStart, theme.Background, "Hijri")
+	for i, name := range PrayerNames {
+		x := margin + dateWidth + hijriWidth + prayerWidth*(float64(i)+0.5)
+		l.text(x, y+baseline, 14, true, anchorMiddle, theme.Background, name.String())
+	}
+	y += rowHeight
+
+	for i, pt := range t {
+		if i%2 == 1 {
+			l.rect(margin, y, width-2*margin, rowHeight, theme.Stripe)
+		}
+		friday := pt.GregorianDate.Weekday() == time.Friday
+		l.text(margin+8, y+baseline, 14, friday, anchorStart, theme.Foreground, pt.GregorianDate.Format("Mon 02.01.2006"))
+		l.text(margin+dateWidth+8, y+baseline, 14, false, anchorStart, theme.Muted, pt.HijriDateShort)
+		for j, name := range PrayerNames {
+			c := theme.Foreground
+			if name == Sunrise {
+				c = theme.Muted
+			}
+			x := margin + dateWidth + hijriWidth + prayerWidth*(float64(j)+0.5)
+			l.text(x, y+baseline, 14, friday, anchorMiddle, c, pt.Clock(name))
+		}
+		y += rowHeight
+	}
+	l.height = y + margin
+	return l
+}
+
+// WriteSVG renders the timetable as an SVG image: a card with the prayer times if it holds a single day,
+// a table with a row per day otherwise. Text is kept as text, so it is rendered with the viewer's fonts.
+func (t Timetable) WriteSVG(w io.Writer, opts ImageOptions) error {
+	l, err := t.layout(opts)
+	if err != nil {
+		return err
+	}
+	family := opts.FontFamily
+	if family == "" {
+		family = "'Noto Sans', 'DejaVu Sans', 'Segoe UI', Roboto, Arial, sans-serif"
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%g" height="%g" viewBox="0 0 %g %g" font-family="%s">`+"\n",
+		l.width, l.height, l.width, l.height, html.EscapeString(family))
+	fmt.Fprintf(bw, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", svgColor(l.background))
+	for _, r := range l.rects {
+		fmt.Fprintf(bw, `<rect x="%g" y="%g" width="%g" height="%g" fill="%s"/>`+"\n", r.x, r.y, r.width, r.height, svgColor(r.color))
+	}
+	for _, text := range l.texts {
+		fmt.Fprintf(bw, `<text x="%g" y="%g" font-size="%g" fill="%s"`, text.x, text.y, text.size, svgColor(text.color))
+		if text.bold {
+			bw.WriteString(` font-weight="bold"`)
+		}
+		switch text.anchor {
+		case anchorMiddle:
+			bw.WriteString(` text-anchor="middle"`)
+		case anchorEnd:
+			bw.WriteString(` text-anchor="end"`)
+		}
+		fmt.Fprintf(bw, ">%s</text>\n", html.EscapeString(text.s))
+	}
+	bw.WriteString("</svg>\n")
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write timetable as SVG: %w", err)
+	}
+	return nil
+}
+
+// WritePNG renders the timetable as a PNG image with the same layout as [Timetable.WriteSVG].
+func (t Timetable) WritePNG(w io.Writer, opts ImageOptions) error {
+	l, err := t.layout(opts)
+	if err != nil {
+		return err
+	}
+	scale := opts.Scale
+	if scale <= 0 {
+		scale = 1
+	}
+
+	regular, bold := opts.Font, opts.BoldFont
+	if regular == nil {
+		regular = goregular.TTF
+	}
+	if bold == nil {
+		bold = gobold.TTF
+	}
+	fonts := make(map[bool]*opentype.Font, 2)
+	for isBold, data := range map[bool][]byte{false: regular, true: bold} {
+		if fonts[isBold], err = opentype.Parse(data); err != nil {
+			return fmt.Errorf(errorPrefix+"unable to parse font: %w", err)
+		}
+	}
+
+	px := func(v float64) int { return int(math.Round(v * scale)) }
+	img := image.NewRGBA(image.Rect(0, 0, px(l.width), px(l.height)))
+	draw.Draw(img, img.Bounds(), image.NewUniform(l.background), image.Point{}, draw.Src)
+	for _, r := range l.rects {
+		bounds := image.Rect(px(r.x), px(r.y), px(r.x+r.width), px(r.y+r.height))
+		draw.Draw(img, bounds, image.NewUniform(r.color), image.Point{}, draw.Over)
+	}
+
+	type faceKey struct {
+		bold bool
+		size float64
+	}
+	faces := make(map[faceKey]font.Face)
+	defer func() {
+		for _, face := range faces {
+			face.Close()
+		}
+	}()
+	for _, text := range l.texts {
+		key := faceKey{text.bold, text.size}
+		face, ok := faces[key]
+		if !ok {
+			face, err = opentype.NewFace(fonts[text.bold], &opentype.FaceOptions{
+				Size: text.size * scale, DPI: 72, Hinting: font.HintingFull,
+			})
+			if err != nil {
+				return fmt.Errorf(errorPrefix+"unable to load font: %w", err)
+			}
+			faces[key] = face
+		}
+
+		d := font.Drawer{Dst: img, Src: image.NewUniform(text.color), Face: face}
+		x := fixed.I(px(text.x))
+		switch text.anchor {
+		case anchorMiddle:
+			x -= d.MeasureString(text.s) / 2
+		case anchorEnd:
+			x -= d.MeasureString(text.s)
+		}
+		d.Dot = fixed.Point26_6{X: x, Y: fixed.I(px(text.y))}
+		d.DrawString(text.s)
+	}
+
+	if err := png.Encode(w, img); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write timetable as PNG: %w", err)
+	}
+	return nil
+}
+
+// svgColor formats c as a CSS color.
+func svgColor(c color.Color) string {
+	r, g, b, a := c.RGBA()
+	if a == 0xFFFF {
+		return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8)
+	}
+	if a == 0 {
+		return "transparent"
+	}
+	// RGBA returns alpha-premultiplied components.
+	return fmt.Sprintf("rgba(%d,%d,%d,%.3f)", r*0xFF/a, g*0xFF/a, b*0xFF/a, float64(a)/0xFFFF)
+}
@@ -0,0 +1,97 @@
+package diyanet
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// tableCellReplacer replaces line breaks and the org-mode column separator, which would break the tables.
+var tableCellReplacer = strings.NewReplacer("\n", " ", "|", "/")
+
+// TextTableOptions configures [Timetable.WriteText] and [Timetable.WriteOrg].
+type TextTableOptions struct {
+	// Columns are the columns written. Defaults to [DefaultTimetableColumns].
+	Columns []TimetableColumn
+	// NoHeader omits the header row.
+	NoHeader bool
+}
+
+// WriteText writes the timetable as a plain-text table with aligned columns, for terminals and monospaced notes.
+func (t Timetable) WriteText(w io.Writer, opts TextTableOptions) error {
+	return t.writeTable(w, opts, "", "  ", "", func(widths []int) string {
+		rules := make([]string, len(widths))
+		for i, width := range widths {
+			rules[i] = strings.Repeat("-", width)
+		}
+		return strings.Join(rules, "  ")
+	})
+}
+
+// WriteOrg writes the timetable as an Emacs org-mode table, with the header separated by a horizontal rule.
+func (t Timetable) WriteOrg(w io.Writer, opts TextTableOptions) error {
+	return t.writeTable(w, opts, "| ", " | ", " |", func(widths []int) string {
+		rules := make([]string, len(widths))
+		for i, width := range widths {
+			rules[i] = strings.Repeat("-", width+2)
+		}
+		return "|" + strings.Join(rules, "+") + "|"
+	})
+}
+
+// writeTable writes the timetable as a table of padded cells joined by the separator and enclosed by prefix and
+// suffix, with the rule returned for the column widths below the header.
+func (t Timetable) writeTable(w io.Writer, opts TextTableOptions, prefix, separator, suffix string, rule func(widths []int) string) error {
+	columns := opts.Columns
+	if len(columns) == 0 {
+		columns = DefaultTimetableColumns()
+	}
+
+	var rows [][]string
+	if !opts.NoHeader {
+		header := make([]string, len(columns))
+		for i, column := range columns {
+			header[i] = column.Header
+		}
+		rows = append(rows, header)
+	}
+	for _, pt := range t {
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			row[i] = tableCellReplacer.Replace(column.Value(pt))
+		}
+		rows = append(rows, row)
+	}
+
+	widths := make([]int, len(columns))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	for r, row := range rows {
+		line := prefix
+		for i, cell := range row {
+			if i > 0 {
+				line += separator
+			}
+			line += cell + strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+		}
+		line += suffix
+		if suffix == "" {
+			line = strings.TrimRight(line, " ")
+		}
+		bw.WriteString(line + "\n")
+		if r == 0 && !opts.NoHeader {
+			bw.WriteString(rule(widths) + "\n")
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write timetable as table: %w", err)
+	}
+	return nil
+}
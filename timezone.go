@@ -0,0 +1,262 @@
+package diyanet
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TimezoneResolver maps places returned by the Diyanet Awqat Salah API to IANA time zones,
+// so that prayer times spanning DST transitions get the correct offset for every day.
+//
+// Places are looked up by their country and city names in Turkish or English, as found in [CityDetail].
+// Countries spanning several time zones can only be resolved for the cities listed in the built-in
+// table or in Overrides.
+type TimezoneResolver struct {
+	// Overrides maps "COUNTRY/CITY" or "COUNTRY" names to IANA zone names (e.g. "Europe/Berlin").
+	// Names are compared in the form of [NormalizePlaceName]. Overrides take precedence over the built-in table.
+	Overrides map[string]string
+}
+
+// Resolve returns the IANA location of the given city in the given country.
+func (r TimezoneResolver) Resolve(country, city string) (*time.Location, error) {
+	country, city = timezoneKey(country), timezoneKey(city)
+
+	tables := []map[string]string{normalizedTimezones(r.Overrides), builtinTimezones()}
+	for _, table := range tables {
+		zone, ok := table[country+"/"+city]
+		if !ok {
+			zone, ok = table[country]
+		}
+		if !ok {
+			continue
+		}
+
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"unable to load time zone %s for %s/%s: %w", zone, country, city, err)
+		}
+		return loc, nil
+	}
+
+	return nil, fmt.Errorf(errorPrefix+"no time zone known for %s/%s", country, city)
+}
+
+// ResolveTimezone returns the IANA location of the city, looking up its country via the Diyanet Awqat Salah API.
+// The result can be passed as timezone to the prayer time methods.
+func (c City) ResolveTimezone(r TimezoneResolver) (*time.Location, error) {
+	detail, err := c.GetCityDetail()
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []string
+	for _, names := range [][2]string{
+		{detail.CountryEn, detail.CityEn},
+		{detail.Country, detail.City},
+		{detail.Country, c.Name},
+	} {
+		loc, err := r.Resolve(names[0], names[1])
+		if err == nil {
+			return loc, nil
+		}
+		errs = append(errs, err.Error())
+	}
+
+	return nil, fmt.Errorf(errorPrefix+"unable to resolve time zone for city %s (%d – %s): %s",
+		c.Name, c.Id, c.Code, strings.Join(errs, "; "))
+}
+
+func timezoneKey(name string) string {
+	return NormalizePlaceName(name)
+}
+
+// normalizedTimezones returns a copy of table with its keys in the form of [timezoneKey].
+func normalizedTimezones(table map[string]string) map[string]string {
+	normalized := make(map[string]string, len(table))
+	for key, zone := range table {
+		country, city, ok := strings.Cut(key, "/")
+		if ok {
+			normalized[timezoneKey(country)+"/"+timezoneKey(city)] = zone
+		} else {
+			normalized[timezoneKey(key)] = zone
+		}
+	}
+	return normalized
+}
+
+// builtinTimezones returns the built-in city and country tables with normalized keys.
+var builtinTimezones = sync.OnceValue(func() map[string]string {
+	table := normalizedTimezones(countryTimezones)
+	maps.Copy(table, normalizedTimezones(cityTimezones))
+	return table
+})
+
+// cityTimezones covers major cities of countries spanning several time zones.
+var cityTimezones = map[string]string{
+	"USA/NEW YORK":            "America/New_York",
+	"USA/WASHINGTON":          "America/New_York",
+	"USA/BOSTON":              "America/New_York",
+	"USA/PHILADELPHIA":        "America/New_York",
+	"USA/MIAMI":               "America/New_York",
+	"USA/ATLANTA":             "America/New_York",
+	"USA/DETROIT":             "America/Detroit",
+	"USA/CHICAGO":             "America/Chicago",
+	"USA/HOUSTON":             "America/Chicago",
+	"USA/DALLAS":              "America/Chicago",
+	"USA/DENVER":              "America/Denver",
+	"USA/PHOENIX":             "America/Phoenix",
+	"USA/LOS ANGELES":         "America/Los_Angeles",
+	"USA/SAN FRANCISCO":       "America/Los_Angeles",
+	"USA/SEATTLE":             "America/Los_Angeles",
+	"CANADA/TORONTO":          "America/Toronto",
+	"CANADA/OTTAWA":           "America/Toronto",
+	"CANADA/MONTREAL":         "America/Toronto",
+	"CANADA/WINNIPEG":         "America/Winnipeg",
+	"CANADA/CALGARY":          "America/Edmonton",
+	"CANADA/EDMONTON":         "America/Edmonton",
+	"CANADA/VANCOUVER":        "America/Vancouver",
+	"RUSSIA/MOSCOW":           "Europe/Moscow",
+	"RUSSIA/SAINT PETERSBURG": "Europe/Moscow",
+	"RUSSIA/KAZAN":            "Europe/Moscow",
+	"RUSSIA/UFA":              "Asia/Yekaterinburg",
+	"RUSSIA/YEKATERINBURG":    "Asia/Yekaterinburg",
+	"RUSSIA/NOVOSIBIRSK":      "Asia/Novosibirsk",
+	"AUSTRALIA/SYDNEY":        "Australia/Sydney",
+	"AUSTRALIA/MELBOURNE":     "Australia/Melbourne",
+	"AUSTRALIA/BRISBANE":      "Australia/Brisbane",
+	"AUSTRALIA/ADELAIDE":      "Australia/Adelaide",
+	"AUSTRALIA/PERTH":         "Australia/Perth",
+	"BRAZIL/SAO PAULO":        "America/Sao_Paulo",
+	"BRAZIL/RIO DE JANEIRO":   "America/Sao_Paulo",
+	"BRAZIL/BRASILIA":         "America/Sao_Paulo",
+	"KAZAKHSTAN/ALMATY":       "Asia/Almaty",
+	"KAZAKHSTAN/ASTANA":       "Asia/Almaty",
+	"INDONESIA/JAKARTA":       "Asia/Jakarta",
+	"MEXICO/MEXICO CITY":      "America/Mexico_City",
+}
+
+// countryTimezones covers countries within a single time zone, by English and Turkish name.
+var countryTimezones = map[string]string{
+	"TURKEY":                    "Europe/Istanbul",
+	"TÜRKIYE":                   "Europe/Istanbul",
+	"GERMANY":                   "Europe/Berlin",
+	"ALMANYA":                   "Europe/Berlin",
+	"AUSTRIA":                   "Europe/Vienna",
+	"AVUSTURYA":                 "Europe/Vienna",
+	"BELGIUM":                   "Europe/Brussels",
+	"BELÇIKA":                   "Europe/Brussels",
+	"NETHERLANDS":               "Europe/Amsterdam",
+	"HOLLANDA":                  "Europe/Amsterdam",
+	"FRANCE":                    "Europe/Paris",
+	"FRANSA":                    "Europe/Paris",
+	"UNITED KINGDOM":            "Europe/London",
+	"ENGLAND":                   "Europe/London",
+	"INGILTERE":                 "Europe/London",
+	"SWITZERLAND":               "Europe/Zurich",
+	"ISVIÇRE":                   "Europe/Zurich",
+	"DENMARK":                   "Europe/Copenhagen",
+	"DANIMARKA":                 "Europe/Copenhagen",
+	"SWEDEN":                    "Europe/Stockholm",
+	"ISVEÇ":                     "Europe/Stockholm",
+	"NORWAY":                    "Europe/Oslo",
+	"NORVEÇ":                    "Europe/Oslo",
+	"FINLAND":                   "Europe/Helsinki",
+	"FINLANDIYA":                "Europe/Helsinki",
+	"ITALY":                     "Europe/Rome",
+	"ITALYA":                    "Europe/Rome",
+	"SPAIN":                     "Europe/Madrid",
+	"ISPANYA":                   "Europe/Madrid",
+	"PORTUGAL":                  "Europe/Lisbon",
+	"PORTEKIZ":                  "Europe/Lisbon",
+	"IRELAND":                   "Europe/Dublin",
+	"IRLANDA":                   "Europe/Dublin",
+	"LUXEMBOURG":                "Europe/Luxembourg",
+	"LÜKSEMBURG":                "Europe/Luxembourg",
+	"POLAND":                    "Europe/Warsaw",
+	"POLONYA":                   "Europe/Warsaw",
+	"CZECHIA":                   "Europe/Prague",
+	"CZECH REPUBLIC":            "Europe/Prague",
+	"ÇEKYA":                     "Europe/Prague",
+	"HUNGARY":                   "Europe/Budapest",
+	"MACARISTAN":                "Europe/Budapest",
+	"ROMANIA":                   "Europe/Bucharest",
+	"ROMANYA":                   "Europe/Bucharest",
+	"BULGARIA":                  "Europe/Sofia",
+	"BULGARISTAN":               "Europe/Sofia",
+	"GREECE":                    "Europe/Athens",
+	"YUNANISTAN":                "Europe/Athens",
+	"BOSNIA AND HERZEGOVINA":    "Europe/Sarajevo",
+	"BOSNA HERSEK":              "Europe/Sarajevo",
+	"NORTH MACEDONIA":           "Europe/Skopje",
+	"MACEDONIA":                 "Europe/Skopje",
+	"MAKEDONYA":                 "Europe/Skopje",
+	"KUZEY MAKEDONYA":           "Europe/Skopje",
+	"KOSOVO":                    "Europe/Belgrade",
+	"KOSOVA":                    "Europe/Belgrade",
+	"ALBANIA":                   "Europe/Tirane",
+	"ARNAVUTLUK":                "Europe/Tirane",
+	"SERBIA":                    "Europe/Belgrade",
+	"SIRBISTAN":                 "Europe/Belgrade",
+	"MONTENEGRO":                "Europe/Podgorica",
+	"KARADAĞ":                   "Europe/Podgorica",
+	"CROATIA":                   "Europe/Zagreb",
+	"HIRVATISTAN":               "Europe/Zagreb",
+	"SLOVENIA":                  "Europe/Ljubljana",
+	"SLOVENYA":                  "Europe/Ljubljana",
+	"UKRAINE":                   "Europe/Kyiv",
+	"UKRAYNA":                   "Europe/Kyiv",
+	"GEORGIA":                   "Asia/Tbilisi",
+	"GÜRCISTAN":                 "Asia/Tbilisi",
+	"AZERBAIJAN":                "Asia/Baku",
+	"AZERBAYCAN":                "Asia/Baku",
+	"NORTHERN CYPRUS":           "Asia/Famagusta",
+	"KKTC":                      "Asia/Famagusta",
+	"KIBRIS":                    "Asia/Famagusta",
+	"SAUDI ARABIA":              "Asia/Riyadh",
+	"SUUDI ARABISTAN":           "Asia/Riyadh",
+	"EGYPT":                     "Africa/Cairo",
+	"MISIR":                     "Africa/Cairo",
+	"JAPAN":                     "Asia/Tokyo",
+	"JAPONYA":                   "Asia/Tokyo",
+	"SOUTH KOREA":               "Asia/Seoul",
+	"GÜNEY KORE":                "Asia/Seoul",
+	"PAKISTAN":                  "Asia/Karachi",
+	"IRAN":                      "Asia/Tehran",
+	"IRAQ":                      "Asia/Baghdad",
+	"IRAK":                      "Asia/Baghdad",
+	"SYRIA":                     "Asia/Damascus",
+	"SURIYE":                    "Asia/Damascus",
+	"JORDAN":                    "Asia/Amman",
+	"ÜRDÜN":                     "Asia/Amman",
+	"LEBANON":                   "Asia/Beirut",
+	"LÜBNAN":                    "Asia/Beirut",
+	"QATAR":                     "Asia/Qatar",
+	"KATAR":                     "Asia/Qatar",
+	"KUWAIT":                    "Asia/Kuwait",
+	"KUVEYT":                    "Asia/Kuwait",
+	"UNITED ARAB EMIRATES":      "Asia/Dubai",
+	"BIRLEŞIK ARAP EMIRLIKLERI": "Asia/Dubai",
+	"TURKMENISTAN":              "Asia/Ashgabat",
+	"UZBEKISTAN":                "Asia/Tashkent",
+	"ÖZBEKISTAN":                "Asia/Tashkent",
+	"KYRGYZSTAN":                "Asia/Bishkek",
+	"KIRGIZISTAN":               "Asia/Bishkek",
+	"AFGHANISTAN":               "Asia/Kabul",
+	"AFGANISTAN":                "Asia/Kabul",
+	"MALAYSIA":                  "Asia/Kuala_Lumpur",
+	"MALEZYA":                   "Asia/Kuala_Lumpur",
+	"SOUTH AFRICA":              "Africa/Johannesburg",
+	"GÜNEY AFRIKA":              "Africa/Johannesburg",
+	"MOROCCO":                   "Africa/Casablanca",
+	"FAS":                       "Africa/Casablanca",
+	"TUNISIA":                   "Africa/Tunis",
+	"TUNUS":                     "Africa/Tunis",
+	"ALGERIA":                   "Africa/Algiers",
+	"CEZAYIR":                   "Africa/Algiers",
+	"LIBYA":                     "Africa/Tripoli",
+	"NEW ZEALAND":               "Pacific/Auckland",
+	"YENI ZELANDA":              "Pacific/Auckland",
+}
@@ -0,0 +1,40 @@
+package diyanet
+
+import (
+	"fmt"
+	"time"
+)
+
+// TripLeg is a stay in one city during a trip.
+type TripLeg struct {
+	// Timetable holds the prayer times of the city stayed in.
+	Timetable Timetable
+	// Until is the last date spent in the city. It is ignored for the last leg, which lasts until the end of the trip.
+	Until time.Time
+}
+
+// StitchTimetables builds a single timetable for a trip from start to end (both dates inclusive),
+// taking every day from the timetable of the city stayed in on that day. The legs must be given
+// in travel order. Each day keeps the CityId of the timetable it was taken from.
+func StitchTimetables(start, end time.Time, legs ...TripLeg) (Timetable, error) {
+	if len(legs) == 0 {
+		return nil, fmt.Errorf(errorPrefix + "cannot stitch a trip without legs")
+	}
+
+	var stitched Timetable
+	leg := 0
+	for day := start; daysBetween(day, end) >= 0; day = day.AddDate(0, 0, 1) {
+		for leg < len(legs)-1 && daysBetween(legs[leg].Until, day) > 0 {
+			leg++
+		}
+
+		pt, ok := legs[leg].Timetable.ByDate(day)
+		if !ok {
+			return nil, fmt.Errorf(errorPrefix+"no prayer time for %s in the timetable of trip leg %d",
+				day.Format(time.DateOnly), leg+1)
+		}
+		stitched = append(stitched, pt)
+	}
+
+	return stitched, nil
+}
@@ -0,0 +1,103 @@
+package diyanet
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// AnomalyKind classifies a problem found by [Timetable.Validate].
+type AnomalyKind int
+
+// The kinds of anomalies detected in prayer time data.
+const (
+	// AnomalyInvalidClock is a clock time that cannot be parsed.
+	AnomalyInvalidClock AnomalyKind = iota + 1
+	// AnomalyOrder is a prayer time not later than its predecessor.
+	AnomalyOrder
+	// AnomalyGap is a missing day between two consecutive entries.
+	AnomalyGap
+	// AnomalyOffset is an implausible GMT offset or an abrupt change of it.
+	AnomalyOffset
+)
+
+var anomalyKindStrings = [...]string{
+	AnomalyInvalidClock: "invalid clock",
+	AnomalyOrder:        "order",
+	AnomalyGap:          "gap",
+	AnomalyOffset:       "offset",
+}
+
+// String returns a short name of the anomaly kind.
+func (k AnomalyKind) String() string {
+	if k <= 0 || int(k) >= len(anomalyKindStrings) {
+		return fmt.Sprintf("AnomalyKind(%d)", int(k))
+	}
+	return anomalyKindStrings[k]
+}
+
+// Anomaly is a structured warning about suspicious prayer time data.
+type Anomaly struct {
+	// Kind classifies the anomaly.
+	Kind AnomalyKind
+	// Date is the date of the affected entry.
+	Date time.Time
+	// Message describes the anomaly.
+	Message string
+}
+
+// String returns a description including the date.
+func (a Anomaly) String() string {
+	return fmt.Sprintf("%s: %s: %s", a.Date.Format(time.DateOnly), a.Kind, a.Message)
+}
+
+// Validate checks the timetable for anomalies the upstream API occasionally serves:
+// unparsable clock times, prayer times out of order (Fajr < Sunrise < Dhuhr < Asr < Maghrib < Isha),
+// missing days and implausible GMT offsets. Isha after midnight is not reported.
+// It returns nil if no anomalies were found.
+func (t Timetable) Validate() []Anomaly {
+	var anomalies []Anomaly
+	report := func(kind AnomalyKind, date time.Time, format string, args ...any) {
+		anomalies = append(anomalies, Anomaly{Kind: kind, Date: date, Message: fmt.Sprintf(format, args...)})
+	}
+
+	for i, pt := range t {
+		date := pt.GregorianDate
+
+		var prev *Prayer
+		var fajr time.Time
+		for _, name := range PrayerNames {
+			at, err := parseClock(date, pt.Clock(name))
+			if err != nil {
+				report(AnomalyInvalidClock, date, "%s time %q: %v", name, pt.Clock(name), err)
+				continue
+			}
+			if name == Fajr {
+				fajr = at
+			}
+			if prev != nil && !at.After(prev.Time) && !(name == Isha && !fajr.IsZero() && at.Before(fajr)) {
+				report(AnomalyOrder, date, "%s (%s) is not after %s (%s)",
+					name, pt.Clock(name), prev.Name, prev.Time.Format("15:04"))
+			}
+			prev = &Prayer{Name: name, Time: at}
+		}
+
+		offset := float64(pt.GreenwichMeanTimeZone)
+		if offset < -12 || offset > 14 || math.Mod(offset*4, 1) != 0 {
+			report(AnomalyOffset, date, "implausible GMT offset %g", offset)
+		}
+
+		if i == 0 {
+			continue
+		}
+		prevDay := t[i-1]
+		if gap := daysBetween(prevDay.GregorianDate, date); gap > 1 {
+			report(AnomalyGap, date, "%d day(s) missing after %s", gap-1, prevDay.GregorianDate.Format(time.DateOnly))
+		}
+		if change := math.Abs(offset - float64(prevDay.GreenwichMeanTimeZone)); change > 1 {
+			report(AnomalyOffset, date, "GMT offset changed by %g hours from the previous day", change)
+		}
+	}
+
+	return anomalies
+}
@@ -0,0 +1,116 @@
+package diyanet
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultWebcalWeeks is the number of weeks served by [WebcalHandler] when none is given.
+// The Diyanet Awqat Salah API serves the prayer times of the next 30 days.
+const DefaultWebcalWeeks = 4
+
+// DefaultWebcalRefreshInterval is how often calendar applications are asked to reload a [WebcalHandler] calendar.
+const DefaultWebcalRefreshInterval = 12 * time.Hour
+
+// WebcalHandler is an [http.Handler] serving an iCalendar subscription per city, so that subscribed calendars
+// always contain the prayer times of the coming weeks without re-imports. The city ID is the last element
+// of the request path, with an optional ".ics" extension, e.g. "/calendars/9541.ics":
+//
+//	http.Handle("/calendars/", diyanet.WebcalHandler{Client: client})
+//
+// The prayer times are retrieved with the client, so they are cached as configured (see [Config.Cache]).
+// Calendar applications subscribe with the webcal:// form of the URL, see [WebcalURL].
+type WebcalHandler struct {
+	// Client retrieves the prayer times.
+	Client Client
+	// Weeks is the number of weeks covered, starting today. Defaults to [DefaultWebcalWeeks].
+	// Beyond the days served by the API, prayer times are only available from Store.
+	Weeks int
+	// Store, if set, provides prayer times in addition to the API, e.g. collected by [Client.BulkDownload].
+	Store TimetableStore
+	// Options configures the calendars. RefreshInterval defaults to [DefaultWebcalRefreshInterval].
+	Options ICalOptions
+}
+
+// ServeHTTP implements [http.Handler].
+func (h WebcalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	id, err := strconv.Atoi(strings.TrimSuffix(path.Base(r.URL.Path), ".ics"))
+	if err != nil || id <= 0 {
+		http.NotFound(w, r)
+		return
+	}
+
+	client := h.Client.WithContext(r.Context())
+	city := City{Id: id, client: client}
+	if detail, err := client.GetCityDetail(id); err == nil {
+		city.Name, city.Code = detail.Name, detail.Code
+	}
+
+	times, err := city.GetPrayerTimeMonthly(nil)
+	if err != nil {
+		log.Printf("%s; serving error", err)
+		http.Error(w, "prayer times unavailable", http.StatusBadGateway)
+		return
+	}
+
+	weeks := h.Weeks
+	if weeks <= 0 {
+		weeks = DefaultWebcalWeeks
+	}
+	loc, err := city.location(nil)
+	if err != nil {
+		log.Printf("%s; serving error", err)
+		http.Error(w, "prayer times unavailable", http.StatusBadGateway)
+		return
+	}
+	start := time.Now().In(loc)
+	end := start.AddDate(0, 0, 7*weeks-1)
+	timetable := NewTimetable(times)
+	if h.Store != nil {
+		if stored, err := h.Store.Timetable(r.Context(), id, start, end); err == nil {
+			timetable = stored.Merge(timetable)
+		} else {
+			log.Printf("%s; serving prayer times of the API only", err)
+		}
+	}
+
+	opts := h.Options
+	if opts.RefreshInterval <= 0 {
+		opts.RefreshInterval = DefaultWebcalRefreshInterval
+	}
+	if opts.Name == "" && city.Name != "" {
+		opts.Name = city.Name
+	}
+
+	var calendar bytes.Buffer
+	if err := WriteICal(&calendar, city, timetable.Range(start, end), opts); err != nil {
+		log.Printf("%s; serving error", err)
+		http.Error(w, "prayer times unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "max-age="+strconv.Itoa(int(time.Hour.Seconds())))
+	w.Write(calendar.Bytes())
+}
+
+// WebcalURL returns the webcal:// form of an http:// or https:// calendar URL, which makes browsers and
+// operating systems offer to subscribe to the calendar instead of importing it once.
+func WebcalURL(calendarURL string) string {
+	for _, scheme := range []string{"https://", "http://"} {
+		if rest, ok := strings.CutPrefix(calendarURL, scheme); ok {
+			return "webcal://" + rest
+		}
+	}
+	return calendarURL
+}
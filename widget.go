@@ -0,0 +1,252 @@
+package diyanet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// widgetScript updates the countdown of a widget every second and marks the row of the next prayer.
+// It is called with the root element of the widget.
+const widgetScript = `function (root) {
+  var prayers = JSON.parse(root.getAttribute("data-prayers"));
+  var countdown = root.querySelector(".diyanet-widget-countdown");
+  var rows = root.querySelectorAll("tr[data-prayer]");
+  function pad(n) { return (n < 10 ? "0" : "") + n; }
+  function tick() {
+    var now = Date.now();
+    for (var i = 0; i < prayers.length; i++) {
+      if (prayers[i].time > now) {
+        var s = Math.floor((prayers[i].time - now) / 1000);
+        countdown.textContent = prayers[i].name + " in " +
+          pad(Math.floor(s / 3600)) + ":" + pad(Math.floor(s / 60) % 60) + ":" + pad(s % 60);
+        for (var j = 0; j < rows.length; j++) {
+          rows[j].className = rows[j].getAttribute("data-prayer") === prayers[i].name && i < rows.length
+            ? "diyanet-widget-next" : "";
+        }
+        return;
+      }
+    }
+    countdown.textContent = "";
+  }
+  tick();
+  setInterval(tick, 1000);
+}`
+
+// widgetHTML lays out the prayer times of a day with a countdown to the next prayer.
+var widgetHTML = htmltemplate.Must(htmltemplate.New("widget").Parse(
+	`<div class="diyanet-widget" data-prayers="{{.Prayers}}">
+  {{- if .Style}}
+  <style>
+.diyanet-widget{font-family:sans-serif;max-width:20em;border:1px solid #ccc;border-radius:.5em;padding:.75em}
+.diyanet-widget-title{font-weight:bold;font-size:1.2em}
+.diyanet-widget-date{color:#666;font-size:.9em}
+.diyanet-widget table{width:100%;border-collapse:collapse;margin:.5em 0}
+.diyanet-widget th{text-align:left;font-weight:normal}
+.diyanet-widget td{text-align:right}
+.diyanet-widget-next{font-weight:bold;background:#e2efda}
+.diyanet-widget-countdown{text-align:center;font-weight:bold}
+</style>
+  {{- end}}
+  {{- if .Title}}
+  <div class="diyanet-widget-title">{{.Title}}</div>
+  {{- end}}
+  <div class="diyanet-widget-date">{{.Date}}</div>
+  <table>
+  {{- range .Rows}}
+    <tr data-prayer="{{.Name}}"{{if .Next}} class="diyanet-widget-next"{{end}}><th>{{.Name}}</th><td>{{.Clock}}</td></tr>
+  {{- end}}
+  </table>
+  <div class="diyanet-widget-countdown">{{.Countdown}}</div>
+  {{- if .StructuredData}}
+  <script type="application/ld+json">{{.StructuredData}}</script>
+  {{- end}}
+</div>
+{{- if .Script}}
+<script>({{.Script}})(document.currentScript.previousElementSibling);</script>
+{{- end}}
+`))
+
+// widgetRow is a prayer of the day as passed to the template.
+type widgetRow struct {
+	Name  PrayerName
+	Clock string
+	Next  bool
+}
+
+// widgetPrayer is a prayer as passed to the countdown script.
+type widgetPrayer struct {
+	Name PrayerName `json:"name"`
+	Time int64      `json:"time"`
+}
+
+// WidgetOptions configures [Timetable.WriteWidget].
+type WidgetOptions struct {
+	// Title heads the widget, typically the city name.
+	Title string
+	// Now is the current time in the location of the city; the widget shows the prayer times of its day.
+	// Defaults to [time.Now].
+	Now time.Time
+	// NoStyle omits the style sheet, for pages styling the "diyanet-widget" classes themselves.
+	NoStyle bool
+	// StructuredData, if set, adds JSON-LD markup of the prayers of the day for search engines,
+	// see [Timetable.WriteJSONLD]. The location defaults to the title.
+	StructuredData *JSONLDOptions
+}
+
+// WriteWidget writes a self-contained HTML fragment showing the prayer times of the day with a countdown to the
+// next prayer, which an inline script updates every second. The timetable must hold the day; if it also holds
+// the following day, the countdown continues to its Fajr after Isha. See [WidgetHandler] for embedding with a
+// single script tag.
+func (t Timetable) WriteWidget(w io.Writer, opts WidgetOptions) error {
+	return t.writeWidget(w, opts, true)
+}
+
+// writeWidget writes the widget, with or without the countdown script.
+func (t Timetable) writeWidget(w io.Writer, opts WidgetOptions, script bool) error {
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	today, ok := t.ByDate(now)
+	if !ok {
+		return errors.New(errorPrefix + "unable to render widget without prayer times of " + dateKey(now))
+	}
+	prayers, err := sortedPrayers(t.Range(now, now.AddDate(0, 0, 1)))
+	if err != nil {
+		return err
+	}
+	next := firstPrayerAfter(prayers, now)
+
+	data := struct {
+		Style          bool
+		Title          string
+		Date           string
+		Rows           []widgetRow
+		Countdown      string
+		Prayers        string
+		Script         htmltemplate.JS
+		StructuredData htmltemplate.JS
+	}{Style: !opts.NoStyle, Title: opts.Title, Date: today.GregorianDate.Format("Monday, 2 January 2006")}
+	if today.HijriDateLong != "" {
+		data.Date += " · " + today.HijriDateLong
+	}
+	for i, name := range PrayerNames {
+		data.Rows = append(data.Rows, widgetRow{Name: name, Clock: today.Clock(name), Next: next == i})
+	}
+	if next < len(prayers) {
+		data.Countdown = prayers[next].Name.String() + " in " + widgetDuration(prayers[next].Time.Sub(now))
+	}
+	list := make([]widgetPrayer, len(prayers))
+	for i, prayer := range prayers {
+		list[i] = widgetPrayer{Name: prayer.Name, Time: prayer.Time.UnixMilli()}
+	}
+	encoded, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	data.Prayers = string(encoded)
+	if script {
+		data.Script = widgetScript
+	}
+	if opts.StructuredData != nil {
+		ld := *opts.StructuredData
+		if ld.Location == "" {
+			ld.Location = opts.Title
+		}
+		markup, err := t.Range(now, now).jsonLD(ld)
+		if err != nil {
+			return err
+		}
+		data.StructuredData = htmltemplate.JS(markup) // JSON without "<", see jsonLD
+	}
+	return widgetHTML.Execute(w, data)
+}
+
+// widgetDuration formats d as hours, minutes and seconds like the countdown script, e.g. "01:02:03".
+func widgetDuration(d time.Duration) string {
+	s := int(d / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
+}
+
+// WidgetHandler is an [http.Handler] serving the widget of [Timetable.WriteWidget] per city, so that websites
+// embed the prayer times of a city with a single tag. The city ID is the last element of the request path:
+// with a ".js" extension, a script inserting the widget after its script tag is served, e.g.
+//
+//	<script src="https://example.com/widget/9541.js"></script>
+//
+// and otherwise the HTML fragment, e.g. for server-side includes or iframes.
+type WidgetHandler struct {
+	// Client retrieves the prayer times and city names.
+	Client Client
+	// Options configures the widgets. The title defaults to the city name; Now is ignored.
+	Options WidgetOptions
+}
+
+// ServeHTTP implements [http.Handler].
+func (h WidgetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	name, script := strings.CutSuffix(path.Base(r.URL.Path), ".js")
+	id, err := strconv.Atoi(strings.TrimSuffix(name, ".html"))
+	if err != nil || id <= 0 {
+		http.NotFound(w, r)
+		return
+	}
+
+	client := h.Client.WithContext(r.Context())
+	city := City{Id: id, client: client}
+	opts := h.Options
+	if opts.Title == "" {
+		if detail, err := client.GetCityDetail(id); err == nil {
+			opts.Title = detail.Name
+		}
+	}
+
+	// The monthly prayer times are cached, unlike the weekly ones.
+	times, err := city.GetPrayerTimeMonthly(nil)
+	if err != nil {
+		log.Printf("%s; serving error", err)
+		http.Error(w, "prayer times unavailable", http.StatusBadGateway)
+		return
+	}
+	loc, err := city.location(nil)
+	if err != nil {
+		log.Printf("%s; serving error", err)
+		http.Error(w, "prayer times unavailable", http.StatusBadGateway)
+		return
+	}
+	opts.Now = time.Now().In(loc)
+
+	var fragment bytes.Buffer
+	if err := NewTimetable(times).writeWidget(&fragment, opts, !script); err != nil {
+		log.Printf("%s; serving error", err)
+		http.Error(w, "prayer times unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "max-age="+strconv.Itoa(int((5*time.Minute).Seconds())))
+	if !script {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(fragment.Bytes())
+		return
+	}
+	// The fragment is inserted as a JSON string; json.Marshal escapes "<" and ">", so it cannot end the script.
+	encoded, _ := json.Marshal(fragment.String())
+	w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+	w.Write([]byte("(function () {\n  var script = document.currentScript;\n  script.insertAdjacentHTML(\"afterend\", "))
+	w.Write(encoded)
+	w.Write([]byte(");\n  (" + widgetScript + ")(script.nextElementSibling);\n})();\n"))
+}
@@ -0,0 +1,200 @@
+package diyanet
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Cell styles of the sheets written by [Timetable.WriteXLSX], indexes into cellXfs of xlsxStyles.
+const (
+	xlsxStyleTitle = iota + 1
+	xlsxStyleHeader
+	xlsxStyleDay
+	xlsxStyleFriday
+	xlsxStyleRamadan
+	xlsxStyleRamadanFriday
+)
+
+// xlsxStyles is the style sheet of the workbooks written by [Timetable.WriteXLSX].
+const xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<fonts count="3">
+<font><sz val="11"/><name val="Calibri"/></font>
+<font><b/><sz val="11"/><name val="Calibri"/></font>
+<font><b/><sz val="14"/><name val="Calibri"/></font>
+</fonts>
+<fills count="5">
+<fill><patternFill patternType="none"/></fill>
+<fill><patternFill patternType="gray125"/></fill>
+<fill><patternFill patternType="solid"><fgColor rgb="FFD9E1F2"/><bgColor indexed="64"/></patternFill></fill>
+<fill><patternFill patternType="solid"><fgColor rgb="FFFFF2CC"/><bgColor indexed="64"/></patternFill></fill>
+<fill><patternFill patternType="solid"><fgColor rgb="FFE2EFDA"/><bgColor indexed="64"/></patternFill></fill>
+</fills>
+<borders count="2">
+<border><left/><right/><top/><bottom/><diagonal/></border>
+<border><left style="thin"><color auto="1"/></left><right style="thin"><color auto="1"/></right><top style="thin"><color auto="1"/></top><bottom style="thin"><color auto="1"/></bottom><diagonal/></border>
+</borders>
+<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>
+<cellXfs count="7">
+<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>
+<xf numFmtId="0" fontId="2" fillId="0" borderId="0" xfId="0" applyFont="1" applyAlignment="1"><alignment horizontal="center"/></xf>
+<xf numFmtId="0" fontId="1" fillId="2" borderId="1" xfId="0" applyFont="1" applyFill="1" applyBorder="1" applyAlignment="1"><alignment horizontal="center"/></xf>
+<xf numFmtId="0" fontId="0" fillId="0" borderId="1" xfId="0" applyBorder="1" applyAlignment="1"><alignment horizontal="center"/></xf>
+<xf numFmtId="0" fontId="1" fillId="3" borderId="1" xfId="0" applyFont="1" applyFill="1" applyBorder="1" applyAlignment="1"><alignment horizontal="center"/></xf>
+<xf numFmtId="0" fontId="0" fillId="4" borderId="1" xfId="0" applyFill="1" applyBorder="1" applyAlignment="1"><alignment horizontal="center"/></xf>
+<xf numFmtId="0" fontId="1" fillId="4" borderId="1" xfId="0" applyFont="1" applyFill="1" applyBorder="1" applyAlignment="1"><alignment horizontal="center"/></xf>
+</cellXfs>
+<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>
+</styleSheet>
+`
+
+// xlsxContentTypes and xlsxRels are the fixed parts of the workbooks; the former takes the overrides of the sheets.
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>
+%s</Types>
+`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>
+`
+)
+
+// XLSXOptions configures [Timetable.WriteXLSX].
+type XLSXOptions struct {
+	// Title is shown in the header of every sheet after the month, typically the city name.
+	Title string
+}
+
+// WriteXLSX writes the timetable as an Excel workbook with one printable sheet per month. Every sheet has
+// a merged header with the month and title, and a row per day with the Gregorian and Hijri dates and the
+// prayer times. Fridays are set in bold on a yellow background, days of Ramadan on a green background.
+func (t Timetable) WriteXLSX(w io.Writer, opts XLSXOptions) error {
+	if len(t) == 0 {
+		return errors.New(errorPrefix + "unable to write empty timetable as XLSX")
+	}
+
+	months := t.months()
+	var (
+		overrides strings.Builder
+		sheets    strings.Builder
+		rels      strings.Builder
+	)
+	for i, month := range months {
+		fmt.Fprintf(&overrides, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`+"\n", i+1)
+		fmt.Fprintf(&sheets, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, month[0].GregorianDate.Format("2006-01"), i+1, i+1)
+		fmt.Fprintf(&rels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`+"\n", i+1, i+1)
+	}
+	fmt.Fprintf(&rels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`+"\n", len(months)+1)
+
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", fmt.Sprintf(xlsxContentTypes, overrides.String())},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets>` + sheets.String() + `</sheets>
+</workbook>
+`},
+		{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+` + rels.String() + `</Relationships>
+`},
+		{"xl/styles.xml", xlsxStyles},
+	}
+	for i, month := range months {
+		parts = append(parts, struct{ name, content string }{
+			fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), xlsxSheet(month, opts.Title),
+		})
+	}
+
+	zw := zip.NewWriter(w)
+	for _, part := range parts {
+		f, err := zw.Create(part.name)
+		if err == nil {
+			_, err = io.WriteString(f, part.content)
+		}
+		if err != nil {
+			return fmt.Errorf(errorPrefix+"unable to write timetable as XLSX: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf(errorPrefix+"unable to write timetable as XLSX: %w", err)
+	}
+	return nil
+}
+
+// xlsxSheet returns the worksheet of the days of a month.
+func xlsxSheet(days Timetable, title string) string {
+	headers := []string{"Date", "Day", "Hijri"}
+	for _, name := range PrayerNames {
+		headers = append(headers, name.String())
+	}
+	lastColumn := xlsxColumn(len(headers) - 1)
+
+	heading := days[0].GregorianDate.Month().String() + " " + days[0].GregorianDate.Format("2006")
+	if title != "" {
+		heading += " – " + title
+	}
+
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheetPr><pageSetUpPr fitToPage="1"/></sheetPr>
+<cols><col min="1" max="1" width="12" customWidth="1"/><col min="2" max="3" width="11" customWidth="1"/><col min="4" max="9" width="9" customWidth="1"/></cols>
+<sheetData>
+`)
+	xlsxRow(&b, 1, xlsxStyleTitle, heading)
+	xlsxRow(&b, 2, xlsxStyleHeader, headers...)
+	for i, pt := range days {
+		style := xlsxStyleDay
+		friday := pt.GregorianDate.Weekday() == time.Friday
+		ramadanDay := !pt.HijriDate.IsZero() && int(pt.HijriDate.Month()) == ramadan
+		switch {
+		case friday && ramadanDay:
+			style = xlsxStyleRamadanFriday
+		case friday:
+			style = xlsxStyleFriday
+		case ramadanDay:
+			style = xlsxStyleRamadan
+		}
+
+		values := []string{pt.GregorianDate.Format("02.01.2006"), pt.GregorianDate.Weekday().String(), pt.HijriDateShort}
+		for _, name := range PrayerNames {
+			values = append(values, pt.Clock(name))
+		}
+		xlsxRow(&b, i+3, style, values...)
+	}
+	fmt.Fprintf(&b, `</sheetData>
+<mergeCells count="1"><mergeCell ref="A1:%s1"/></mergeCells>
+<pageMargins left="0.5" right="0.5" top="0.5" bottom="0.5" header="0.3" footer="0.3"/>
+<pageSetup paperSize="9" orientation="portrait" fitToWidth="1" fitToHeight="1"/>
+</worksheet>
+`, lastColumn)
+	return b.String()
+}
+
+// xlsxRow writes a row of inline string cells with the given style.
+func xlsxRow(b *strings.Builder, row, style int, values ...string) {
+	fmt.Fprintf(b, `<row r="%d">`, row)
+	for i, value := range values {
+		fmt.Fprintf(b, `<c r="%s%d" s="%d" t="inlineStr"><is><t>`, xlsxColumn(i), row, style)
+		_ = xml.EscapeText(b, []byte(value))
+		b.WriteString(`</t></is></c>`)
+	}
+	b.WriteString("</row>\n")
+}
+
+// xlsxColumn returns the letter of the zero-based column index, up to Z.
+func xlsxColumn(i int) string {
+	return string(rune('A' + i))
+}